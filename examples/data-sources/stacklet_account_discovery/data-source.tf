@@ -0,0 +1,8 @@
+# Fetch an account discovery configuration by name
+data "stacklet_account_discovery" "aws" {
+  name = "aws-organization"
+}
+
+output "aws_org_id" {
+  value = data.stacklet_account_discovery.aws.aws.org_id
+}
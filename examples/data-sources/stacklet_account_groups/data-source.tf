@@ -0,0 +1,20 @@
+# Fetch all AWS account groups
+data "stacklet_account_groups" "aws" {
+  cloud_provider = "AWS"
+}
+
+data "stacklet_user_group" "operators" {
+  name = "operators"
+}
+
+# Grant a role on every AWS account group
+resource "stacklet_role_assignment" "operators" {
+  for_each = {
+    for group in data.stacklet_account_groups.aws.account_groups :
+    group.name => group
+  }
+
+  role_name = "viewer"
+  principal = data.stacklet_user_group.operators.role_assignment_principal
+  target    = each.value.role_assignment_target
+}
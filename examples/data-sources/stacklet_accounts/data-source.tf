@@ -0,0 +1,28 @@
+# Fetch all AWS production accounts
+data "stacklet_accounts" "aws_production" {
+  cloud_provider = "AWS"
+  active         = true
+  tags = {
+    environment = "production"
+  }
+}
+
+# Fetch accounts by email contact address
+data "stacklet_accounts" "platform" {
+  email = "platform@example.com"
+}
+
+data "stacklet_account_group" "production" {
+  name = "production-accounts"
+}
+
+# Add all matching accounts to a group
+resource "stacklet_account_group_mapping" "production" {
+  for_each = {
+    for account in data.stacklet_accounts.aws_production.accounts :
+    account.key => account
+  }
+
+  group_uuid  = data.stacklet_account_group.production.uuid
+  account_key = each.key
+}
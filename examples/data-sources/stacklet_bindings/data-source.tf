@@ -0,0 +1,17 @@
+data "stacklet_account_group" "production" {
+  name = "production-accounts"
+}
+
+# Fetch all non-system bindings for an account group
+data "stacklet_bindings" "production" {
+  account_group_uuid = data.stacklet_account_group.production.uuid
+  system             = false
+}
+
+# Report on all the bindings for the account group
+resource "stacklet_report_group" "production" {
+  name     = "production"
+  schedule = "0 12 * * *"
+  bindings = [for binding in data.stacklet_bindings.production.bindings : binding.uuid]
+  group_by = ["account", "region"]
+}
@@ -0,0 +1,8 @@
+# Fetch all Slack notification templates
+data "stacklet_notification_templates" "slack" {
+  transport = "slack"
+}
+
+output "slack_templates" {
+  value = [for template in data.stacklet_notification_templates.slack.templates : template.name]
+}
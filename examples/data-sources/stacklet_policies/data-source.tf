@@ -0,0 +1,27 @@
+# Fetch all AWS cost policies
+data "stacklet_policies" "aws_cost" {
+  cloud_provider = "AWS"
+  category       = "cost"
+}
+
+# Fetch custom policies
+data "stacklet_policies" "custom" {
+  system = false
+}
+
+resource "stacklet_policy_collection" "aws_cost" {
+  name           = "aws-cost"
+  cloud_provider = "AWS"
+}
+
+# Add all matching policies to the collection
+resource "stacklet_policy_collection_mapping" "aws_cost" {
+  for_each = {
+    for policy in data.stacklet_policies.aws_cost.policies :
+    policy.unqualified_name => policy
+  }
+
+  collection_uuid = stacklet_policy_collection.aws_cost.uuid
+  policy_uuid     = each.value.uuid
+  policy_version  = each.value.version
+}
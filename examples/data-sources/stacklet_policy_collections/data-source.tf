@@ -0,0 +1,23 @@
+data "stacklet_repository" "policies" {
+  url = "ssh://git@example.com/my-policies.git"
+}
+
+# Fetch all dynamic policy collections backed by a repository
+data "stacklet_policy_collections" "from_repository" {
+  dynamic         = true
+  repository_uuid = data.stacklet_repository.policies.uuid
+}
+
+# Fetch all custom AWS policy collections
+data "stacklet_policy_collections" "aws_custom" {
+  cloud_provider = "AWS"
+  system         = false
+}
+
+output "collection_branches" {
+  description = "The repository branch for each collection"
+  value = {
+    for collection in data.stacklet_policy_collections.from_repository.policy_collections :
+    collection.name => collection.dynamic_config.branch_name
+  }
+}
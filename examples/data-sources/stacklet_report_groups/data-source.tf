@@ -0,0 +1,25 @@
+data "stacklet_bindings" "all" {
+  system = false
+}
+
+# Fetch all enabled report groups for bindings
+data "stacklet_report_groups" "enabled" {
+  source  = "BINDING"
+  enabled = true
+}
+
+locals {
+  reported_bindings = toset(flatten([
+    for report_group in data.stacklet_report_groups.enabled.report_groups : report_group.bindings
+  ]))
+}
+
+# Ensure every binding is covered by at least one enabled report group
+check "bindings_reported" {
+  assert {
+    condition = alltrue([
+      for binding in data.stacklet_bindings.all.bindings : contains(local.reported_bindings, binding.uuid)
+    ])
+    error_message = "Some bindings are not included in any enabled report group."
+  }
+}
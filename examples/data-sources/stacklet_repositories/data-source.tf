@@ -0,0 +1,13 @@
+# Fetch all user-managed repositories hosted on GitHub
+data "stacklet_repositories" "github" {
+  host   = "github.com"
+  system = false
+}
+
+# Report repositories without any credentials configured
+output "unauthenticated_repositories" {
+  value = [
+    for repo in data.stacklet_repositories.github.repositories : repo.url
+    if !repo.has_auth_token && !repo.has_ssh_private_key
+  ]
+}
@@ -0,0 +1,19 @@
+# Fetch all roles
+data "stacklet_roles" "all" {}
+
+# Fetch roles that allow writing bindings
+data "stacklet_roles" "binding_writers" {
+  permission = "binding:write"
+}
+
+# Find the role with the fewest permissions that allows writing bindings
+locals {
+  least_privileged_binding_writer = [
+    for role in data.stacklet_roles.binding_writers.roles : role.name
+    if length(role.permissions) == min([for r in data.stacklet_roles.binding_writers.roles : length(r.permissions)]...)
+  ][0]
+}
+
+output "system_roles" {
+  value = [for role in data.stacklet_roles.all.roles : role.name if role.system]
+}
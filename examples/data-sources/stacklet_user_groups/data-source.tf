@@ -0,0 +1,14 @@
+# Fetch all user groups
+data "stacklet_user_groups" "all" {}
+
+# Grant a viewer role to all platform teams
+resource "stacklet_role_assignment" "platform_viewer" {
+  for_each = {
+    for group in data.stacklet_user_groups.all.user_groups :
+    group.name => group if startswith(group.name, "platform-")
+  }
+
+  role_name = "viewer"
+  principal = each.value.role_assignment_principal
+  target    = "system:all"
+}
@@ -0,0 +1,21 @@
+# Fetch all active users
+data "stacklet_users" "active" {
+  active = true
+}
+
+# Grant a viewer role to all active SSO users
+resource "stacklet_role_assignment" "sso_viewer" {
+  for_each = {
+    for user in data.stacklet_users.active.users :
+    user.username => user if user.sso_user
+  }
+
+  role_name = "viewer"
+  principal = each.value.role_assignment_principal
+  target    = "system:all"
+}
+
+# List local (non-SSO) users, e.g. to detect stale accounts
+output "local_usernames" {
+  value = [for user in data.stacklet_users.active.users : user.username if !user.sso_user]
+}
@@ -0,0 +1,4 @@
+import {
+  to = stacklet_account_group_members.example
+  id = "$group_uuid"
+}
@@ -0,0 +1 @@
+terraform import stacklet_account_group_members.example $group_uuid
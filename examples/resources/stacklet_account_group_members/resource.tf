@@ -0,0 +1,17 @@
+data "stacklet_account_group" "production" {
+  name = "production-accounts"
+}
+
+data "stacklet_accounts" "production" {
+  cloud_provider = "AWS"
+  tags = {
+    environment = "production"
+  }
+}
+
+# Manage the full set of accounts in the group. Accounts not listed here are
+# removed from the group.
+resource "stacklet_account_group_members" "production" {
+  group_uuid   = data.stacklet_account_group.production.uuid
+  account_keys = [for account in data.stacklet_accounts.production.accounts : account.key]
+}
@@ -0,0 +1,4 @@
+import {
+  to = stacklet_policy_collection_policies.example
+  id = "$collection_uuid"
+}
@@ -0,0 +1 @@
+terraform import stacklet_policy_collection_policies.example $collection_uuid
@@ -0,0 +1,23 @@
+data "stacklet_policy_collection" "cost" {
+  name = "cost-optimization"
+}
+
+data "stacklet_policies" "cost" {
+  cloud_provider = "AWS"
+  category       = "cost"
+}
+
+# Manage the full set of policies in the collection. Policies not listed here
+# are removed from the collection.
+resource "stacklet_policy_collection_policies" "cost" {
+  collection_uuid = data.stacklet_policy_collection.cost.uuid
+  policies = concat(
+    # track the latest version of all cost policies
+    [for policy in data.stacklet_policies.cost.policies : { policy_uuid = policy.uuid, policy_version = null }],
+    # pin a specific version of a policy
+    [{
+      policy_uuid    = "2a07c561-d1b0-4cb6-ad81-22013d2daf6e"
+      policy_version = 3
+    }],
+  )
+}
@@ -0,0 +1,4 @@
+import {
+  to = stacklet_role_assignments.example
+  id = "$target"
+}
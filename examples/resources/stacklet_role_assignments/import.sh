@@ -0,0 +1 @@
+terraform import stacklet_role_assignments.example $target
@@ -0,0 +1,27 @@
+data "stacklet_account_group" "production" {
+  name = "production"
+}
+
+data "stacklet_user" "alice" {
+  username = "alice"
+}
+
+data "stacklet_user_group" "auditors" {
+  name = "auditors"
+}
+
+# Manage all role assignments on the account group. Assignments not listed
+# here are revoked.
+resource "stacklet_role_assignments" "production" {
+  target = data.stacklet_account_group.production.role_assignment_target
+  assignments = [
+    {
+      role_name = "editor"
+      principal = data.stacklet_user.alice.role_assignment_principal
+    },
+    {
+      role_name = "viewer"
+      principal = data.stacklet_user_group.auditors.role_assignment_principal
+    },
+  ]
+}
@@ -0,0 +1,4 @@
+import {
+  to = stacklet_sso_group_grants.example
+  id = "$sso_group"
+}
@@ -0,0 +1 @@
+terraform import stacklet_sso_group_grants.example $sso_group
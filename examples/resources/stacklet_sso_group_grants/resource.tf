@@ -0,0 +1,24 @@
+resource "stacklet_sso_group" "platform" {
+  name         = "platform-engineers"
+  display_name = "Platform Engineers"
+}
+
+data "stacklet_account_group" "production" {
+  name = "production"
+}
+
+# Manage all the roles the SSO group grants to its members. Role assignments
+# not listed here are revoked.
+resource "stacklet_sso_group_grants" "platform" {
+  sso_group = stacklet_sso_group.platform.name
+  role_assignments = [
+    {
+      role_name = "viewer"
+      target    = "system:all"
+    },
+    {
+      role_name = "editor"
+      target    = data.stacklet_account_group.production.role_assignment_target
+    },
+  ]
+}
@@ -0,0 +1,43 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountDiscoveryDataSource(t *testing.T) {
+	steps := []resource.TestStep{
+		{
+			Config: `
+				resource "stacklet_account_discovery_aws" "test" {
+					name = "{{.Prefix}}-discovery-ds-aws"
+					description = "AWS org discovery"
+					org_read_role = "arn:aws:iam::123456789012:role/org-read"
+					member_role = "arn:aws:iam::{account_id}:role/member"
+					custodian_role = "custodian"
+				}
+
+				data "stacklet_account_discovery" "test" {
+					name = stacklet_account_discovery_aws.test.name
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair("data.stacklet_account_discovery.test", "id", "stacklet_account_discovery_aws.test", "id"),
+				resource.TestCheckResourceAttr("data.stacklet_account_discovery.test", "name", prefixName("discovery-ds-aws")),
+				resource.TestCheckResourceAttr("data.stacklet_account_discovery.test", "description", "AWS org discovery"),
+				resource.TestCheckResourceAttr("data.stacklet_account_discovery.test", "cloud_provider", "AWS"),
+				resource.TestCheckResourceAttr("data.stacklet_account_discovery.test", "suspended", "false"),
+				resource.TestCheckResourceAttrPair("data.stacklet_account_discovery.test", "aws.org_id", "stacklet_account_discovery_aws.test", "org_id"),
+				resource.TestCheckResourceAttr("data.stacklet_account_discovery.test", "aws.org_read_role", "arn:aws:iam::123456789012:role/org-read"),
+				resource.TestCheckResourceAttr("data.stacklet_account_discovery.test", "aws.member_role", "arn:aws:iam::{account_id}:role/member"),
+				resource.TestCheckResourceAttr("data.stacklet_account_discovery.test", "aws.custodian_role", "custodian"),
+				resource.TestCheckNoResourceAttr("data.stacklet_account_discovery.test", "azure"),
+				resource.TestCheckNoResourceAttr("data.stacklet_account_discovery.test", "gcp"),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccAccountDiscoveryDataSource", steps)
+}
@@ -0,0 +1,110 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountGroupMembersResource(t *testing.T) {
+	baseline := `
+		resource "stacklet_account_group" "test" {
+			name = "{{.Prefix}}-ag-members"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_account" "one" {
+			name = "{{.Prefix}}-ag-members-1"
+			key = "999999999921"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_account" "two" {
+			name = "{{.Prefix}}-ag-members-2"
+			key = "999999999922"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_account" "three" {
+			name = "{{.Prefix}}-ag-members-3"
+			key = "999999999923"
+			cloud_provider = "AWS"
+		}
+	`
+	members := `
+		resource "stacklet_account_group_members" "test" {
+			group_uuid = stacklet_account_group.test.uuid
+			account_keys = [stacklet_account.one.key, stacklet_account.two.key]
+		}
+	`
+	steps := []resource.TestStep{
+		// An account is added to the group outside of the authoritative resource
+		{
+			Config: baseline + `
+				resource "stacklet_account_group_mapping" "unmanaged" {
+					group_uuid = stacklet_account_group.test.uuid
+					account_key = stacklet_account.three.key
+				}
+			`,
+			Check: resource.TestCheckResourceAttrSet("stacklet_account_group_mapping.unmanaged", "id"),
+		},
+		// Create and Read testing, members not in the config are removed
+		{
+			Config: baseline + members + `
+				removed {
+					from = stacklet_account_group_mapping.unmanaged
+					lifecycle {
+						destroy = false
+					}
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair("stacklet_account_group_members.test", "id", "stacklet_account_group.test", "uuid"),
+				resource.TestCheckResourceAttr("stacklet_account_group_members.test", "account_keys.#", "2"),
+				resource.TestCheckTypeSetElemAttr("stacklet_account_group_members.test", "account_keys.*", "999999999921"),
+				resource.TestCheckTypeSetElemAttr("stacklet_account_group_members.test", "account_keys.*", "999999999922"),
+			),
+		},
+		// The unmanaged mapping no longer exists
+		{
+			Config: baseline + members + `
+				import {
+					to = stacklet_account_group_mapping.unmanaged
+					id = "${stacklet_account_group.test.uuid}:${stacklet_account.three.key}"
+				}
+
+				resource "stacklet_account_group_mapping" "unmanaged" {
+					group_uuid = stacklet_account_group.test.uuid
+					account_key = stacklet_account.three.key
+				}
+			`,
+			ExpectError: regexp.MustCompile("Cannot import non-existent remote object"),
+		},
+		// ImportState testing
+		{
+			Config:            baseline + members,
+			ResourceName:      "stacklet_account_group_members.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: importStateIDFuncFromAttrs("stacklet_account_group.test.uuid"),
+		},
+		// Update and Read testing
+		{
+			Config: baseline + `
+				resource "stacklet_account_group_members" "test" {
+					group_uuid = stacklet_account_group.test.uuid
+					account_keys = [stacklet_account.two.key, stacklet_account.three.key]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("stacklet_account_group_members.test", "account_keys.#", "2"),
+				resource.TestCheckTypeSetElemAttr("stacklet_account_group_members.test", "account_keys.*", "999999999922"),
+				resource.TestCheckTypeSetElemAttr("stacklet_account_group_members.test", "account_keys.*", "999999999923"),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccAccountGroupMembersResource", steps)
+}
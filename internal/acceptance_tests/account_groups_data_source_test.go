@@ -0,0 +1,82 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountGroupsDataSource(t *testing.T) {
+	baseline := `
+		resource "stacklet_account_group" "one" {
+			name = "{{.Prefix}}-account-groups-ds-1"
+			cloud_provider = "AWS"
+			regions = ["us-east-1", "us-east-2"]
+		}
+
+		resource "stacklet_account_group" "two" {
+			name = "{{.Prefix}}-account-groups-ds-2"
+			cloud_provider = "AWS"
+			dynamic_filter = "tag:Environment=prod"
+		}
+
+		resource "stacklet_account_group" "azure" {
+			name = "{{.Prefix}}-account-groups-ds-3"
+			cloud_provider = "Azure"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_account_groups" "test" {
+					cloud_provider = "AWS"
+					depends_on = [stacklet_account_group.one, stacklet_account_group.two, stacklet_account_group.azure]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_account_groups.test", "account_groups.*", map[string]string{
+					"name":           prefixName("account-groups-ds-1"),
+					"cloud_provider": "AWS",
+					"regions.#":      "2",
+					"regions.0":      "us-east-1",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_account_groups.test", "account_groups.*", map[string]string{
+					"name":           prefixName("account-groups-ds-2"),
+					"dynamic_filter": "tag:Environment=prod",
+				}),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_account_groups.test", "account_groups.*.uuid",
+					"stacklet_account_group.one", "uuid",
+				),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_account_groups.test", "account_groups.*.role_assignment_target",
+					"stacklet_account_group.two", "role_assignment_target",
+				),
+				testCheckNoTypeSetElemNestedAttrs("data.stacklet_account_groups.test", "account_groups.*", map[string]string{
+					"name": prefixName("account-groups-ds-3"),
+				}),
+			),
+		},
+		// Without filters, all account groups are returned
+		{
+			Config: baseline + `
+				data "stacklet_account_groups" "test" {
+					depends_on = [stacklet_account_group.one, stacklet_account_group.two, stacklet_account_group.azure]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_account_groups.test", "account_groups.*", map[string]string{
+					"name":           prefixName("account-groups-ds-1"),
+					"cloud_provider": "AWS",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_account_groups.test", "account_groups.*", map[string]string{
+					"name":           prefixName("account-groups-ds-3"),
+					"cloud_provider": "Azure",
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccAccountGroupsDataSource", steps)
+}
@@ -0,0 +1,95 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAccountsDataSource(t *testing.T) {
+	baseline := `
+		resource "stacklet_account" "one" {
+			name = "{{.Prefix}}-accounts-ds-1"
+			key = "999999999991"
+			cloud_provider = "AWS"
+			email = "test@example.com"
+		}
+
+		resource "stacklet_account" "two" {
+			name = "{{.Prefix}}-accounts-ds-2"
+			key = "999999999992"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_account" "azure" {
+			name = "{{.Prefix}}-accounts-ds-3"
+			key = "00000000-0000-0000-0000-000000000001"
+			cloud_provider = "Azure"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_accounts" "test" {
+					cloud_provider = "AWS"
+					depends_on = [stacklet_account.one, stacklet_account.two, stacklet_account.azure]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_accounts.test", "accounts.*", map[string]string{
+					"key":            "999999999991",
+					"name":           prefixName("accounts-ds-1"),
+					"cloud_provider": "AWS",
+					"active":         "true",
+					"tags.%":         "0",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_accounts.test", "accounts.*", map[string]string{
+					"key":  "999999999992",
+					"name": prefixName("accounts-ds-2"),
+				}),
+				testCheckNoTypeSetElemNestedAttrs("data.stacklet_accounts.test", "accounts.*", map[string]string{
+					"key": "00000000-0000-0000-0000-000000000001",
+				}),
+			),
+		},
+		{
+			Config: baseline + `
+				data "stacklet_accounts" "test" {
+					email = "test@example.com"
+					active = true
+					depends_on = [stacklet_account.one, stacklet_account.two, stacklet_account.azure]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_accounts.test", "accounts.*", map[string]string{
+					"key":   "999999999991",
+					"email": "test@example.com",
+				}),
+				testCheckNoTypeSetElemNestedAttrs("data.stacklet_accounts.test", "accounts.*", map[string]string{
+					"key": "999999999992",
+				}),
+			),
+		},
+		// Without filters, all accounts are returned
+		{
+			Config: baseline + `
+				data "stacklet_accounts" "test" {
+					depends_on = [stacklet_account.one, stacklet_account.two, stacklet_account.azure]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_accounts.test", "accounts.*", map[string]string{
+					"key":            "999999999991",
+					"cloud_provider": "AWS",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_accounts.test", "accounts.*", map[string]string{
+					"key":            "00000000-0000-0000-0000-000000000001",
+					"cloud_provider": "Azure",
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccAccountsDataSource", steps)
+}
@@ -0,0 +1,111 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBindingsDataSource(t *testing.T) {
+	baseline := `
+		resource "stacklet_account_group" "test" {
+			name = "{{.Prefix}}-bindings-ds-group"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_policy_collection" "one" {
+			name = "{{.Prefix}}-bindings-ds-collection-1"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_policy_collection" "two" {
+			name = "{{.Prefix}}-bindings-ds-collection-2"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_binding" "one" {
+			name = "{{.Prefix}}-bindings-ds-1"
+			account_group_uuid = stacklet_account_group.test.uuid
+			policy_collection_uuid = stacklet_policy_collection.one.uuid
+			auto_deploy = true
+			dry_run = true
+		}
+
+		resource "stacklet_binding" "two" {
+			name = "{{.Prefix}}-bindings-ds-2"
+			account_group_uuid = stacklet_account_group.test.uuid
+			policy_collection_uuid = stacklet_policy_collection.two.uuid
+			auto_deploy = false
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_bindings" "test" {
+					account_group_uuid = stacklet_account_group.test.uuid
+					depends_on = [stacklet_binding.one, stacklet_binding.two]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.stacklet_bindings.test", "bindings.#", "2"),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_bindings.test", "bindings.*", map[string]string{
+					"name":        prefixName("bindings-ds-1"),
+					"auto_deploy": "true",
+					"dry_run":     "true",
+					"system":      "false",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_bindings.test", "bindings.*", map[string]string{
+					"name":        prefixName("bindings-ds-2"),
+					"auto_deploy": "false",
+				}),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_bindings.test", "bindings.*.policy_collection_uuid",
+					"stacklet_policy_collection.one", "uuid",
+				),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_bindings.test", "bindings.*.account_group_uuid",
+					"stacklet_account_group.test", "uuid",
+				),
+			),
+		},
+		{
+			Config: baseline + `
+				data "stacklet_bindings" "test" {
+					account_group_uuid = stacklet_account_group.test.uuid
+					policy_collection_uuid = stacklet_policy_collection.one.uuid
+					auto_deploy = true
+					dry_run = true
+					system = false
+					depends_on = [stacklet_binding.one, stacklet_binding.two]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.stacklet_bindings.test", "bindings.#", "1"),
+				resource.TestCheckResourceAttr("data.stacklet_bindings.test", "bindings.0.name", prefixName("bindings-ds-1")),
+				resource.TestCheckResourceAttrPair(
+					"data.stacklet_bindings.test", "bindings.0.uuid",
+					"stacklet_binding.one", "uuid",
+				),
+			),
+		},
+		// Without filters, all bindings are returned
+		{
+			Config: baseline + `
+				data "stacklet_bindings" "test" {
+					depends_on = [stacklet_binding.one, stacklet_binding.two]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_bindings.test", "bindings.*", map[string]string{
+					"name": prefixName("bindings-ds-1"),
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_bindings.test", "bindings.*", map[string]string{
+					"name": prefixName("bindings-ds-2"),
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccBindingsDataSource", steps)
+}
@@ -0,0 +1,83 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationTemplatesDataSource(t *testing.T) {
+	baseline := `
+		resource "stacklet_notification_template" "slack" {
+			name = "{{.Prefix}}-templates-ds-slack"
+			description = "Slack template"
+			transport = "slack"
+			content = "slack content"
+		}
+
+		resource "stacklet_notification_template" "email" {
+			name = "{{.Prefix}}-templates-ds-email"
+			transport = "email"
+			content = "email content"
+		}
+
+		resource "stacklet_notification_template" "generic" {
+			name = "{{.Prefix}}-templates-ds-generic"
+			content = "generic content"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_notification_templates" "test" {
+					depends_on = [
+						stacklet_notification_template.slack,
+						stacklet_notification_template.email,
+						stacklet_notification_template.generic,
+					]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_notification_templates.test", "templates.*", map[string]string{
+					"name":        prefixName("templates-ds-slack"),
+					"description": "Slack template",
+					"transport":   "slack",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_notification_templates.test", "templates.*", map[string]string{
+					"name":      prefixName("templates-ds-email"),
+					"transport": "email",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_notification_templates.test", "templates.*", map[string]string{
+					"name": prefixName("templates-ds-generic"),
+				}),
+			),
+		},
+		{
+			Config: baseline + `
+				data "stacklet_notification_templates" "test" {
+					transport = "slack"
+					depends_on = [
+						stacklet_notification_template.slack,
+						stacklet_notification_template.email,
+						stacklet_notification_template.generic,
+					]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_notification_templates.test", "templates.*", map[string]string{
+					"name":      prefixName("templates-ds-slack"),
+					"transport": "slack",
+				}),
+				testCheckNoTypeSetElemNestedAttrs("data.stacklet_notification_templates.test", "templates.*", map[string]string{
+					"name": prefixName("templates-ds-email"),
+				}),
+				testCheckNoTypeSetElemNestedAttrs("data.stacklet_notification_templates.test", "templates.*", map[string]string{
+					"name": prefixName("templates-ds-generic"),
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccNotificationTemplatesDataSource", steps)
+}
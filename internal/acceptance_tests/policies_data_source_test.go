@@ -0,0 +1,56 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoliciesDataSource(t *testing.T) {
+	// Policies can't be created, so lookups use a policy shipped with the
+	// platform.
+	baseline := `
+		data "stacklet_policy" "elb" {
+			name = "cost-aws:aws-elb-unattached-inform"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_policies" "test" {
+					cloud_provider = "AWS"
+					category = "cost/waste/lifecycle"
+					mode = "pull"
+					system = true
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_policies.test", "policies.*", map[string]string{
+					"name":             "cost-aws:aws-elb-unattached-inform",
+					"unqualified_name": "aws-elb-unattached-inform",
+					"cloud_provider":   "AWS",
+					"category.#":       "1",
+					"category.0":       "cost/waste/lifecycle",
+					"resource_type":    "aws.elb",
+					"mode":             "pull",
+					"system":           "true",
+					"path":             "aws/aws-elb-unattached.yaml",
+				}),
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_policies.test", "policies.*.uuid", "data.stacklet_policy.elb", "uuid"),
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_policies.test", "policies.*.version", "data.stacklet_policy.elb", "version"),
+			),
+		},
+		// Without filters, all policies are returned
+		{
+			Config: baseline + `
+				data "stacklet_policies" "test" {}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_policies.test", "policies.*.uuid", "data.stacklet_policy.elb", "uuid"),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccPoliciesDataSource", steps)
+}
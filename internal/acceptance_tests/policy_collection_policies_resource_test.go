@@ -0,0 +1,147 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyCollectionPoliciesResource(t *testing.T) {
+	baseline := `
+		resource "stacklet_policy_collection" "test" {
+			name = "{{.Prefix}}-pc-policies"
+			cloud_provider = "AWS"
+		}
+
+		data "stacklet_policy" "elb" {
+			name = "cost-aws:aws-elb-unattached-inform"
+		}
+
+		data "stacklet_policy" "ebs" {
+			name = "cost-aws:aws-ebs-unattached-inform"
+		}
+
+		data "stacklet_policy" "s3" {
+			name = "security-aws:aws-s3-bucket-public"
+		}
+	`
+	policies := `
+		resource "stacklet_policy_collection_policies" "test" {
+			collection_uuid = stacklet_policy_collection.test.uuid
+			policies = [
+				{
+					policy_uuid = data.stacklet_policy.elb.uuid
+					policy_version = 1
+				},
+				{
+					policy_uuid = data.stacklet_policy.ebs.uuid
+					policy_version = data.stacklet_policy.ebs.version
+				},
+			]
+		}
+	`
+	steps := []resource.TestStep{
+		// A policy is added to the collection outside of the authoritative resource
+		{
+			Config: baseline + `
+				resource "stacklet_policy_collection_mapping" "unmanaged" {
+					collection_uuid = stacklet_policy_collection.test.uuid
+					policy_uuid = data.stacklet_policy.s3.uuid
+					policy_version = data.stacklet_policy.s3.version
+				}
+			`,
+			Check: resource.TestCheckResourceAttrSet("stacklet_policy_collection_mapping.unmanaged", "id"),
+		},
+		// Create and Read testing, policies not in the config are removed
+		{
+			Config: baseline + policies + `
+				removed {
+					from = stacklet_policy_collection_mapping.unmanaged
+					lifecycle {
+						destroy = false
+					}
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair("stacklet_policy_collection_policies.test", "id", "stacklet_policy_collection.test", "uuid"),
+				resource.TestCheckResourceAttr("stacklet_policy_collection_policies.test", "policies.#", "2"),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_policy_collection_policies.test", "policies.*.policy_uuid", "data.stacklet_policy.elb", "uuid"),
+				resource.TestCheckTypeSetElemNestedAttrs("stacklet_policy_collection_policies.test", "policies.*", map[string]string{
+					"policy_version": "1",
+				}),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_policy_collection_policies.test", "policies.*.policy_uuid", "data.stacklet_policy.ebs", "uuid"),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_policy_collection_policies.test", "policies.*.policy_version", "data.stacklet_policy.ebs", "version"),
+			),
+		},
+		// The unmanaged mapping no longer exists
+		{
+			Config: baseline + policies + `
+				import {
+					to = stacklet_policy_collection_mapping.unmanaged
+					id = "${stacklet_policy_collection.test.uuid}:${data.stacklet_policy.s3.uuid}"
+				}
+
+				resource "stacklet_policy_collection_mapping" "unmanaged" {
+					collection_uuid = stacklet_policy_collection.test.uuid
+					policy_uuid = data.stacklet_policy.s3.uuid
+					policy_version = data.stacklet_policy.s3.version
+				}
+			`,
+			ExpectError: regexp.MustCompile("Cannot import non-existent remote object"),
+		},
+		// ImportState testing
+		{
+			Config:            baseline + policies,
+			ResourceName:      "stacklet_policy_collection_policies.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: importStateIDFuncFromAttrs("stacklet_policy_collection.test.uuid"),
+		},
+		// Update and Read testing, tracking the latest version
+		{
+			Config: baseline + `
+				resource "stacklet_policy_collection_policies" "test" {
+					collection_uuid = stacklet_policy_collection.test.uuid
+					policies = [
+						{
+							policy_uuid = data.stacklet_policy.elb.uuid
+						},
+						{
+							policy_uuid = data.stacklet_policy.s3.uuid
+							policy_version = data.stacklet_policy.s3.version
+						},
+					]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("stacklet_policy_collection_policies.test", "policies.#", "2"),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_policy_collection_policies.test", "policies.*.policy_uuid", "data.stacklet_policy.elb", "uuid"),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_policy_collection_policies.test", "policies.*.policy_uuid", "data.stacklet_policy.s3", "uuid"),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_policy_collection_policies.test", "policies.*.policy_version", "data.stacklet_policy.s3", "version"),
+			),
+		},
+		// The same policy can't be included more than once
+		{
+			Config: baseline + `
+				resource "stacklet_policy_collection_policies" "test" {
+					collection_uuid = stacklet_policy_collection.test.uuid
+					policies = [
+						{
+							policy_uuid = data.stacklet_policy.elb.uuid
+						},
+						{
+							policy_uuid = data.stacklet_policy.elb.uuid
+							policy_version = 1
+						},
+					]
+				}
+			`,
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("Duplicate Value"),
+		},
+	}
+	runRecordedAccTest(t, "TestAccPolicyCollectionPoliciesResource", steps)
+}
@@ -0,0 +1,103 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPolicyCollectionsDataSource(t *testing.T) {
+	baseline := `
+		resource "stacklet_repository" "test" {
+			url = "https://github.com/test-org/test-repo"
+			name = "{{.Prefix}}-collections-ds-repo"
+		}
+
+		resource "stacklet_policy_collection" "static" {
+			name = "{{.Prefix}}-collections-ds-static"
+			cloud_provider = "AWS"
+			auto_update = true
+		}
+
+		resource "stacklet_policy_collection" "dynamic" {
+			name = "{{.Prefix}}-collections-ds-dynamic"
+			cloud_provider = "AWS"
+			dynamic_config = {
+				repository_uuid = stacklet_repository.test.uuid
+				policy_directories = ["policies"]
+			}
+		}
+
+		resource "stacklet_policy_collection" "azure" {
+			name = "{{.Prefix}}-collections-ds-azure"
+			cloud_provider = "Azure"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_policy_collections" "test" {
+					cloud_provider = "AWS"
+					system = false
+					depends_on = [stacklet_policy_collection.static, stacklet_policy_collection.dynamic, stacklet_policy_collection.azure]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_policy_collections.test", "policy_collections.*", map[string]string{
+					"name":           prefixName("collections-ds-static"),
+					"cloud_provider": "AWS",
+					"auto_update":    "true",
+					"dynamic":        "false",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_policy_collections.test", "policy_collections.*", map[string]string{
+					"name":                                  prefixName("collections-ds-dynamic"),
+					"dynamic":                               "true",
+					"dynamic_config.policy_directories.#":   "1",
+					"dynamic_config.policy_directories.0":   "policies",
+					"dynamic_config.policy_file_suffixes.#": "2",
+				}),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_policy_collections.test", "policy_collections.*.dynamic_config.repository_uuid",
+					"stacklet_repository.test", "uuid",
+				),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_policy_collections.test", "policy_collections.*.role_assignment_target",
+					"stacklet_policy_collection.static", "role_assignment_target",
+				),
+			),
+		},
+		{
+			Config: baseline + `
+				data "stacklet_policy_collections" "test" {
+					dynamic = true
+					repository_uuid = stacklet_repository.test.uuid
+					depends_on = [stacklet_policy_collection.static, stacklet_policy_collection.dynamic, stacklet_policy_collection.azure]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.stacklet_policy_collections.test", "policy_collections.#", "1"),
+				resource.TestCheckResourceAttr("data.stacklet_policy_collections.test", "policy_collections.0.name", prefixName("collections-ds-dynamic")),
+			),
+		},
+		// Without filters, all policy collections are returned
+		{
+			Config: baseline + `
+				data "stacklet_policy_collections" "test" {
+					depends_on = [stacklet_policy_collection.static, stacklet_policy_collection.dynamic, stacklet_policy_collection.azure]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_policy_collections.test", "policy_collections.*", map[string]string{
+					"name": prefixName("collections-ds-static"),
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_policy_collections.test", "policy_collections.*", map[string]string{
+					"name":           prefixName("collections-ds-azure"),
+					"cloud_provider": "Azure",
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccPolicyCollectionsDataSource", steps)
+}
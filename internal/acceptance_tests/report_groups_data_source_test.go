@@ -0,0 +1,107 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReportGroupsDataSource(t *testing.T) {
+	baseline := `
+		resource "stacklet_account_group" "ag" {
+			name = "{{.Prefix}}-report-groups-ds-ag"
+			cloud_provider = "AWS"
+			regions = ["us-east-1"]
+		}
+
+		resource "stacklet_policy_collection" "pc" {
+			name = "{{.Prefix}}-report-groups-ds-pc"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_binding" "b" {
+			name = "{{.Prefix}}-report-groups-ds-binding"
+			account_group_uuid = stacklet_account_group.ag.uuid
+			policy_collection_uuid = stacklet_policy_collection.pc.uuid
+		}
+
+		resource "stacklet_report_group" "enabled" {
+			name = "{{.Prefix}}-report-groups-ds"
+			bindings = [stacklet_binding.b.uuid]
+			schedule = "0 12 * * *"
+			group_by = ["account", "region"]
+			use_message_settings = false
+		}
+
+		resource "stacklet_report_group" "disabled" {
+			name = "{{.Prefix}}-report-groups-ds-disabled"
+			enabled = false
+			bindings = [stacklet_binding.b.uuid]
+			schedule = "0 6 * * *"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_report_groups" "test" {
+					source = "BINDING"
+					binding_uuid = stacklet_binding.b.uuid
+					depends_on = [stacklet_report_group.enabled, stacklet_report_group.disabled]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.stacklet_report_groups.test", "report_groups.#", "2"),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_report_groups.test", "report_groups.*", map[string]string{
+					"name":                 prefixName("report-groups-ds"),
+					"enabled":              "true",
+					"source":               "BINDING",
+					"schedule":             "0 12 * * *",
+					"bindings.#":           "1",
+					"group_by.#":           "2",
+					"use_message_settings": "false",
+				}),
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_report_groups.test", "report_groups.*.bindings.0", "stacklet_binding.b", "uuid"),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_report_groups.test", "report_groups.*", map[string]string{
+					"name":     prefixName("report-groups-ds-disabled"),
+					"enabled":  "false",
+					"schedule": "0 6 * * *",
+				}),
+			),
+		},
+		{
+			Config: baseline + `
+				data "stacklet_report_groups" "test" {
+					source = "BINDING"
+					enabled = true
+					binding_uuid = stacklet_binding.b.uuid
+					depends_on = [stacklet_report_group.enabled, stacklet_report_group.disabled]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.stacklet_report_groups.test", "report_groups.#", "1"),
+				resource.TestCheckResourceAttr("data.stacklet_report_groups.test", "report_groups.0.name", prefixName("report-groups-ds")),
+			),
+		},
+		// Without filters, all report groups are returned
+		{
+			Config: baseline + `
+				data "stacklet_report_groups" "test" {
+					depends_on = [stacklet_report_group.enabled, stacklet_report_group.disabled]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_report_groups.test", "report_groups.*", map[string]string{
+					"name":    prefixName("report-groups-ds"),
+					"enabled": "true",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_report_groups.test", "report_groups.*", map[string]string{
+					"name":    prefixName("report-groups-ds-disabled"),
+					"enabled": "false",
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccReportGroupsDataSource", steps)
+}
@@ -0,0 +1,119 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleAssignmentsResource(t *testing.T) {
+	baseline := `
+		resource "stacklet_account_group" "test" {
+			name = "{{.Prefix}}-role-assignments"
+			cloud_provider = "AWS"
+		}
+
+		resource "stacklet_user" "test" {
+			name = "{{.Prefix}}-role-assignments-user"
+			username = "{{.Prefix}}_role_assignments_user"
+			email = "test@stacklet.io"
+		}
+
+		resource "stacklet_user_group" "test" {
+			name = "{{.Prefix}}-role-assignments-group"
+		}
+	`
+	assignments := `
+		resource "stacklet_role_assignments" "test" {
+			target = stacklet_account_group.test.role_assignment_target
+			assignments = [
+				{ role_name = "viewer", principal = stacklet_user.test.role_assignment_principal },
+				{ role_name = "editor", principal = stacklet_user_group.test.role_assignment_principal },
+			]
+		}
+	`
+	steps := []resource.TestStep{
+		// A role is assigned on the target outside of the authoritative resource
+		{
+			Config: baseline + `
+				resource "stacklet_role_assignment" "unmanaged" {
+					role_name = "editor"
+					principal = stacklet_user.test.role_assignment_principal
+					target = stacklet_account_group.test.role_assignment_target
+				}
+			`,
+			Check: resource.TestCheckResourceAttrSet("stacklet_role_assignment.unmanaged", "id"),
+		},
+		// Create and Read testing, assignments not in the config are revoked
+		{
+			Config: baseline + assignments + `
+				removed {
+					from = stacklet_role_assignment.unmanaged
+					lifecycle {
+						destroy = false
+					}
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair("stacklet_role_assignments.test", "id", "stacklet_account_group.test", "role_assignment_target"),
+				resource.TestCheckResourceAttr("stacklet_role_assignments.test", "assignments.#", "2"),
+				resource.TestCheckTypeSetElemNestedAttrs("stacklet_role_assignments.test", "assignments.*", map[string]string{
+					"role_name": "viewer",
+				}),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_role_assignments.test", "assignments.*.principal", "stacklet_user.test", "role_assignment_principal"),
+				resource.TestCheckTypeSetElemNestedAttrs("stacklet_role_assignments.test", "assignments.*", map[string]string{
+					"role_name": "editor",
+				}),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_role_assignments.test", "assignments.*.principal", "stacklet_user_group.test", "role_assignment_principal"),
+			),
+		},
+		// The unmanaged assignment no longer exists
+		{
+			Config: baseline + assignments + `
+				import {
+					to = stacklet_role_assignment.unmanaged
+					id = "editor,${stacklet_user.test.role_assignment_principal},${stacklet_account_group.test.role_assignment_target}"
+				}
+
+				resource "stacklet_role_assignment" "unmanaged" {
+					role_name = "editor"
+					principal = stacklet_user.test.role_assignment_principal
+					target = stacklet_account_group.test.role_assignment_target
+				}
+			`,
+			ExpectError: regexp.MustCompile("Role assignment not found"),
+		},
+		// ImportState testing
+		{
+			Config:            baseline + assignments,
+			ResourceName:      "stacklet_role_assignments.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: importStateIDFuncFromAttrs("stacklet_account_group.test.role_assignment_target"),
+		},
+		// Update and Read testing, grants and revokes are applied together
+		{
+			Config: baseline + `
+				resource "stacklet_role_assignments" "test" {
+					target = stacklet_account_group.test.role_assignment_target
+					assignments = [
+						{ role_name = "viewer", principal = stacklet_user.test.role_assignment_principal },
+						{ role_name = "viewer", principal = stacklet_user_group.test.role_assignment_principal },
+					]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("stacklet_role_assignments.test", "assignments.#", "2"),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_role_assignments.test", "assignments.*.principal", "stacklet_user.test", "role_assignment_principal"),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_role_assignments.test", "assignments.*.principal", "stacklet_user_group.test", "role_assignment_principal"),
+				testCheckNoTypeSetElemNestedAttrs("stacklet_role_assignments.test", "assignments.*", map[string]string{
+					"role_name": "editor",
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccRoleAssignmentsResource", steps)
+}
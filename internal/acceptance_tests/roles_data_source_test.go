@@ -0,0 +1,59 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRolesDataSource(t *testing.T) {
+	// Roles can't be created, so lookups use the roles shipped with the
+	// platform.
+	baseline := `
+		data "stacklet_role" "owner" {
+			name = "owner"
+		}
+
+		data "stacklet_role" "viewer" {
+			name = "viewer"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_roles" "test" {}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_roles.test", "roles.*", map[string]string{
+					"name":   "owner",
+					"system": "true",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_roles.test", "roles.*", map[string]string{
+					"name":   "viewer",
+					"system": "true",
+				}),
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_roles.test", "roles.*.id", "data.stacklet_role.owner", "id"),
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_roles.test", "roles.*.id", "data.stacklet_role.viewer", "id"),
+			),
+		},
+		{
+			Config: baseline + `
+				data "stacklet_roles" "test" {
+					permission = "binding:write"
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_roles.test", "roles.*", map[string]string{
+					"name": "owner",
+				}),
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_roles.test", "roles.*.id", "data.stacklet_role.owner", "id"),
+				testCheckNoTypeSetElemNestedAttrs("data.stacklet_roles.test", "roles.*", map[string]string{
+					"name": "viewer",
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccRolesDataSource", steps)
+}
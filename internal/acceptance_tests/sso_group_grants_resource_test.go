@@ -0,0 +1,121 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSSOGroupGrantsResource(t *testing.T) {
+	baseline := `
+		resource "stacklet_sso_group" "test" {
+			name = "{{.Prefix}}-sso-grants"
+		}
+
+		resource "stacklet_account_group" "test" {
+			name = "{{.Prefix}}-sso-grants"
+			cloud_provider = "AWS"
+		}
+	`
+	grants := `
+		resource "stacklet_sso_group_grants" "test" {
+			sso_group = stacklet_sso_group.test.name
+			role_assignments = [
+				{ role_name = "viewer", target = "system:all" },
+				{ role_name = "editor", target = stacklet_account_group.test.role_assignment_target },
+			]
+		}
+	`
+	steps := []resource.TestStep{
+		// A role is assigned to the SSO group outside of the authoritative resource
+		{
+			Config: baseline + `
+				resource "stacklet_role_assignment" "unmanaged" {
+					role_name = "viewer"
+					principal = stacklet_sso_group.test.role_assignment_principal
+					target = stacklet_account_group.test.role_assignment_target
+				}
+			`,
+			Check: resource.TestCheckResourceAttrSet("stacklet_role_assignment.unmanaged", "id"),
+		},
+		// Create and Read testing, grants not in the config are removed
+		{
+			Config: baseline + grants + `
+				removed {
+					from = stacklet_role_assignment.unmanaged
+					lifecycle {
+						destroy = false
+					}
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair("stacklet_sso_group_grants.test", "id", "stacklet_sso_group.test", "name"),
+				resource.TestCheckResourceAttr("stacklet_sso_group_grants.test", "role_assignments.#", "2"),
+				resource.TestCheckTypeSetElemNestedAttrs("stacklet_sso_group_grants.test", "role_assignments.*", map[string]string{
+					"role_name": "viewer",
+					"target":    "system:all",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("stacklet_sso_group_grants.test", "role_assignments.*", map[string]string{
+					"role_name": "editor",
+				}),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_sso_group_grants.test", "role_assignments.*.target", "stacklet_account_group.test", "role_assignment_target"),
+			),
+		},
+		// The unmanaged assignment no longer exists
+		{
+			Config: baseline + grants + `
+				import {
+					to = stacklet_role_assignment.unmanaged
+					id = "viewer,${stacklet_sso_group.test.role_assignment_principal},${stacklet_account_group.test.role_assignment_target}"
+				}
+
+				resource "stacklet_role_assignment" "unmanaged" {
+					role_name = "viewer"
+					principal = stacklet_sso_group.test.role_assignment_principal
+					target = stacklet_account_group.test.role_assignment_target
+				}
+			`,
+			ExpectError: regexp.MustCompile("Role assignment not found"),
+		},
+		// ImportState testing
+		{
+			Config:            baseline + grants,
+			ResourceName:      "stacklet_sso_group_grants.test",
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: importStateIDFuncFromAttrs("stacklet_sso_group.test.name"),
+		},
+		// Update and Read testing, assignments are granted and revoked
+		{
+			Config: baseline + `
+				resource "stacklet_sso_group_grants" "test" {
+					sso_group = stacklet_sso_group.test.name
+					role_assignments = [
+						{ role_name = "viewer", target = "system:all" },
+						{ role_name = "viewer", target = stacklet_account_group.test.role_assignment_target },
+					]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("stacklet_sso_group_grants.test", "role_assignments.#", "2"),
+				resource.TestCheckTypeSetElemAttrPair("stacklet_sso_group_grants.test", "role_assignments.*.target", "stacklet_account_group.test", "role_assignment_target"),
+				testCheckNoTypeSetElemNestedAttrs("stacklet_sso_group_grants.test", "role_assignments.*", map[string]string{
+					"role_name": "editor",
+				}),
+			),
+		},
+		// Update and Read testing, role assignments default to none
+		{
+			Config: baseline + `
+				resource "stacklet_sso_group_grants" "test" {
+					sso_group = stacklet_sso_group.test.name
+				}
+			`,
+			Check: resource.TestCheckResourceAttr("stacklet_sso_group_grants.test", "role_assignments.#", "0"),
+		},
+	}
+	runRecordedAccTest(t, "TestAccSSOGroupGrantsResource", steps)
+}
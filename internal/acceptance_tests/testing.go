@@ -63,6 +63,21 @@ func importStateIDFuncFromAttrs(attrs ...string) resource.ImportStateIdFunc {
 	}
 }
 
+// testCheckNoTypeSetElemNestedAttrs checks that no element of a set or list
+// attribute of a resource matches all the specified nested attribute values.
+// It's the opposite of resource.TestCheckTypeSetElemNestedAttrs.
+func testCheckNoTypeSetElemNestedAttrs(name, attr string, values map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[name]; !ok {
+			return fmt.Errorf("resource '%s' not found in state", name)
+		}
+		if resource.TestCheckTypeSetElemNestedAttrs(name, attr, values)(s) == nil {
+			return fmt.Errorf("%s: unexpected element in '%s' with attributes %v", name, attr, values)
+		}
+		return nil
+	}
+}
+
 // runRecordedAccTest runs an acceptance test, with the specified name and steps.
 func runRecordedAccTest(t *testing.T, testName string, testSteps []resource.TestStep) {
 	setupHTTPTransport(t, testName)
@@ -0,0 +1,57 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserGroupsDataSource(t *testing.T) {
+	baseline := `
+		resource "stacklet_user_group" "one" {
+			name = "{{.Prefix}}-user-groups-ds-1"
+			display_name = "First test group"
+		}
+
+		resource "stacklet_user_group" "two" {
+			name = "{{.Prefix}}-user-groups-ds-2"
+		}
+
+		resource "stacklet_user_group" "other" {
+			name = "{{.Prefix}}-user-groups-other"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_user_groups" "test" {
+					depends_on = [stacklet_user_group.one, stacklet_user_group.two, stacklet_user_group.other]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_user_groups.test", "user_groups.*", map[string]string{
+					"name":         prefixName("user-groups-ds-1"),
+					"display_name": "First test group",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_user_groups.test", "user_groups.*", map[string]string{
+					"name": prefixName("user-groups-ds-2"),
+				}),
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_user_groups.test", "user_groups.*.uuid", "stacklet_user_group.one", "uuid"),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_user_groups.test", "user_groups.*.role_assignment_principal",
+					"stacklet_user_group.one", "role_assignment_principal",
+				),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_user_groups.test", "user_groups.*.role_assignment_target",
+					"stacklet_user_group.two", "role_assignment_target",
+				),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_user_groups.test", "user_groups.*", map[string]string{
+					"name": prefixName("user-groups-other"),
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccUserGroupsDataSource", steps)
+}
@@ -0,0 +1,76 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package acceptance_tests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	baseline := `
+		resource "stacklet_user" "one" {
+			name = "{{.Prefix}}-users-ds-1"
+			username = "{{.Prefix}}-users-ds-1"
+			email = "users-ds-1@{{.Prefix}}-users-ds.example.com"
+			display_name = "First test user"
+		}
+
+		resource "stacklet_user" "two" {
+			name = "{{.Prefix}}-users-ds-2"
+			username = "{{.Prefix}}-users-ds-2"
+			email = "users-ds-2@{{.Prefix}}-users-ds.example.com"
+		}
+
+		resource "stacklet_user" "other" {
+			name = "{{.Prefix}}-users-ds-3"
+			username = "{{.Prefix}}-users-ds-3"
+			email = "users-ds-3@{{.Prefix}}-users-ds-other.example.com"
+		}
+	`
+	steps := []resource.TestStep{
+		{
+			Config: baseline + `
+				data "stacklet_users" "test" {
+					active = true
+					depends_on = [stacklet_user.one, stacklet_user.two, stacklet_user.other]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_users.test", "users.*", map[string]string{
+					"username":     prefixName("users-ds-1"),
+					"email":        "users-ds-1@" + prefixName("users-ds.example.com"),
+					"display_name": "First test user",
+					"active":       "true",
+					"sso_user":     "false",
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_users.test", "users.*", map[string]string{
+					"username": prefixName("users-ds-2"),
+				}),
+				resource.TestCheckTypeSetElemAttrPair("data.stacklet_users.test", "users.*.key", "stacklet_user.one", "key"),
+				resource.TestCheckTypeSetElemAttrPair(
+					"data.stacklet_users.test", "users.*.role_assignment_principal",
+					"stacklet_user.two", "role_assignment_principal",
+				),
+			),
+		},
+		// Without filters, all users are returned
+		{
+			Config: baseline + `
+				data "stacklet_users" "test" {
+					depends_on = [stacklet_user.one, stacklet_user.two, stacklet_user.other]
+				}
+			`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_users.test", "users.*", map[string]string{
+					"username": prefixName("users-ds-1"),
+				}),
+				resource.TestCheckTypeSetElemNestedAttrs("data.stacklet_users.test", "users.*", map[string]string{
+					"username": prefixName("users-ds-3"),
+				}),
+			),
+		},
+	}
+	runRecordedAccTest(t, "TestAccUsersDataSource", steps)
+}
@@ -23,6 +23,39 @@ type Account struct {
 	Variables       *string       `graphql:"variables"`
 }
 
+// AccountListEntry is the data returned for each account when listing
+// accounts.
+type AccountListEntry struct {
+	Account
+	Tags TagsList `graphql:"tags"`
+}
+
+// AccountsFilter defines filters for listing accounts. Only set fields are
+// used for filtering.
+type AccountsFilter struct {
+	Provider CloudProvider
+	Email    string
+	Active   *bool
+	Tags     TagsList
+}
+
+func (f AccountsFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.Provider != "" {
+		filters = append(filters, newExactMatchFilter("provider", f.Provider))
+	}
+	if f.Email != "" {
+		filters = append(filters, newExactMatchFilter("email", f.Email))
+	}
+	if f.Active != nil {
+		filters = append(filters, newExactMatchFilter("active", *f.Active))
+	}
+	for _, tag := range f.Tags {
+		filters = append(filters, newExactMatchFilter("tag:"+tag.Key, tag.Value))
+	}
+	return newAllOfFilter(filters)
+}
+
 // AccountCreateInput is the input for creating an account.
 type AccountCreateInput struct {
 	Name            string        `json:"name"`
@@ -79,6 +112,24 @@ func (a accountAPI) Read(ctx context.Context, cloudProvider string, key string)
 	return &query.Account, nil
 }
 
+// List returns accounts matching the filter.
+func (a accountAPI) List(ctx context.Context, filter AccountsFilter) ([]AccountListEntry, error) {
+	return paginate(ctx, func(cursor string) (*connection[AccountListEntry], error) {
+		var query struct {
+			Accounts connection[AccountListEntry] `graphql:"accounts(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      a.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.Accounts, nil
+	})
+}
+
 // Create creates an account.
 func (a accountAPI) Create(ctx context.Context, i AccountCreateInput) (*Account, error) {
 	var mutation struct {
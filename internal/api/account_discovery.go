@@ -16,28 +16,59 @@ type AccountDiscovery struct {
 	Provider    CloudProvider `graphql:"provider"`
 	Config      struct {
 		TypeName    string                      `graphql:"__typename"`
-		AWSConfig   accountDiscoveryAWSConfig   `graphql:"... on AWSAccountDiscoveryConfig"`
-		AzureConfig accountDiscoveryAzureConfig `graphql:"... on AzureAccountDiscoveryConfig"`
-		GCPConfig   accountDiscoveryGCPConfig   `graphql:"... on GCPAccountDiscoveryConfig"`
+		AWSConfig   AccountDiscoveryAWSConfig   `graphql:"... on AWSAccountDiscoveryConfig"`
+		AzureConfig AccountDiscoveryAzureConfig `graphql:"... on AzureAccountDiscoveryConfig"`
+		GCPConfig   AccountDiscoveryGCPConfig   `graphql:"... on GCPAccountDiscoveryConfig"`
 	} `graphql:"config"`
 	Schedule struct {
 		Suspended bool `graphql:"suspended"`
 	} `graphql:"schedule"`
 }
 
-type accountDiscoveryAWSConfig struct {
+// AWSConfig returns the configuration for an AWS account discovery, or nil if
+// the discovery is for a different provider.
+func (d AccountDiscovery) AWSConfig() *AccountDiscoveryAWSConfig {
+	if d.Config.TypeName != "AWSAccountDiscoveryConfig" {
+		return nil
+	}
+	return &d.Config.AWSConfig
+}
+
+// AzureConfig returns the configuration for an Azure account discovery, or
+// nil if the discovery is for a different provider.
+func (d AccountDiscovery) AzureConfig() *AccountDiscoveryAzureConfig {
+	if d.Config.TypeName != "AzureAccountDiscoveryConfig" {
+		return nil
+	}
+	return &d.Config.AzureConfig
+}
+
+// GCPConfig returns the configuration for a GCP account discovery, or nil if
+// the discovery is for a different provider.
+func (d AccountDiscovery) GCPConfig() *AccountDiscoveryGCPConfig {
+	if d.Config.TypeName != "GCPAccountDiscoveryConfig" {
+		return nil
+	}
+	return &d.Config.GCPConfig
+}
+
+// AccountDiscoveryAWSConfig is the configuration for an AWS account discovery.
+type AccountDiscoveryAWSConfig struct {
 	OrgID         string `graphql:"orgID"`
 	OrgRole       string `graphql:"orgRole"`
 	MemberRole    string `graphql:"memberRole"`
 	CustodianRole string `graphql:"custodianRole"`
 }
 
-type accountDiscoveryAzureConfig struct {
+// AccountDiscoveryAzureConfig is the configuration for an Azure account
+// discovery.
+type AccountDiscoveryAzureConfig struct {
 	TenantID string `graphql:"tenantID"`
 	ClientID string `graphql:"clientID"`
 }
 
-type accountDiscoveryGCPConfig struct {
+// AccountDiscoveryGCPConfig is the configuration for a GCP account discovery.
+type AccountDiscoveryGCPConfig struct {
 	ClientEmail      string   `graphql:"clientEmail"`
 	ClientID         string   `graphql:"clientID"`
 	OrgID            string   `graphql:"orgID"`
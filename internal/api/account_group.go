@@ -20,6 +20,20 @@ type AccountGroup struct {
 	RoleAssignmentTarget string     `graphql:"roleAssignmentTarget"`
 }
 
+// AccountGroupsFilter defines filters for listing account groups. Only set
+// fields are used for filtering.
+type AccountGroupsFilter struct {
+	Provider CloudProvider
+}
+
+func (f AccountGroupsFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.Provider != "" {
+		filters = append(filters, newExactMatchFilter("provider", f.Provider))
+	}
+	return newAllOfFilter(filters)
+}
+
 // AccountGroupCreateInput is the input for creating an account group.
 type AccountGroupCreateInput struct {
 	Name          string   `json:"name"`
@@ -71,6 +85,24 @@ func (a accountGroupAPI) Read(ctx context.Context, uuid string, name string) (*A
 	return &query.AccountGroup, nil
 }
 
+// List returns account groups matching the filter.
+func (a accountGroupAPI) List(ctx context.Context, filter AccountGroupsFilter) ([]AccountGroup, error) {
+	return paginate(ctx, func(cursor string) (*connection[AccountGroup], error) {
+		var query struct {
+			AccountGroups connection[AccountGroup] `graphql:"accountGroups(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      a.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.AccountGroups, nil
+	})
+}
+
 // Create creates an account group.
 func (a accountGroupAPI) Create(ctx context.Context, i AccountGroupCreateInput) (*AccountGroup, error) {
 	var mutation struct {
@@ -132,3 +132,96 @@ func (a accountGroupMappingAPI) Delete(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// accountMappingNode is an account mapping listed for an account group.
+type accountMappingNode struct {
+	ID      graphql.ID
+	Account struct {
+		Key string
+	}
+}
+
+// List returns all the account mappings for an account group.
+func (a accountGroupMappingAPI) List(ctx context.Context, groupUUID string) ([]AccountGroupMapping, error) {
+	nodes, err := paginate(ctx, func(cursor string) (*connection[accountMappingNode], error) {
+		var query struct {
+			AccountGroup struct {
+				UUID            string
+				AccountMappings connection[accountMappingNode] `graphql:"accountMappings(first: $pageSize, after: $cursor)"`
+			} `graphql:"accountGroup(uuid: $uuid)"`
+		}
+		variables := map[string]any{
+			"uuid":     graphql.String(groupUUID),
+			"pageSize": a.c.pageSize,
+			"cursor":   graphql.String(cursor),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		if query.AccountGroup.UUID == "" {
+			return nil, NotFound{"Account group not found"}
+		}
+		return &query.AccountGroup.AccountMappings, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mappings := make([]AccountGroupMapping, len(nodes))
+	for i, node := range nodes {
+		mappings[i] = AccountGroupMapping{
+			ID:         node.ID,
+			GroupUUID:  groupUUID,
+			AccountKey: node.Account.Key,
+		}
+	}
+	return mappings, nil
+}
+
+// CreateMany creates account group mappings for multiple accounts in a single
+// request.
+func (a accountGroupMappingAPI) CreateMany(ctx context.Context, groupUUID string, accountKeys []string) error {
+	if len(accountKeys) == 0 {
+		return nil
+	}
+
+	var mutation struct {
+		Payload struct {
+			Mappings []struct {
+				ID graphql.ID
+			}
+		} `graphql:"upsertAccountGroupMappings(input: $input)"`
+	}
+	input := upsertAccountGroupMappingsInput{
+		Mappings: make([]accountGroupMappingInput, len(accountKeys)),
+	}
+	for i, accountKey := range accountKeys {
+		input.Mappings[i] = accountGroupMappingInput{
+			AccountKey: accountKey,
+			GroupUUID:  groupUUID,
+		}
+	}
+	return a.c.Mutate(ctx, &mutation, map[string]any{"input": input})
+}
+
+// DeleteMany removes multiple account group mappings in a single request.
+func (a accountGroupMappingAPI) DeleteMany(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var mutation struct {
+		Payload struct {
+			Removed []struct {
+				ID graphql.ID
+			}
+		} `graphql:"removeAccountGroupMappings(input: $input)"`
+	}
+	input := removeAccountGroupMappingsInput{
+		IDs: make([]graphql.ID, len(ids)),
+	}
+	for i, id := range ids {
+		input.IDs[i] = graphql.ID(id)
+	}
+	return a.c.Mutate(ctx, &mutation, map[string]any{"input": input})
+}
@@ -29,11 +29,15 @@ type API struct {
 	Template                templateAPI
 	User                    userAPI
 	UserGroup               userGroupAPI
+	UserGroupMember         userGroupMemberAPI
 }
 
 // New creates an API wrapper.
-func New(ctx context.Context, config ClientConfig) *API {
-	c := newClient(ctx, config)
+func New(ctx context.Context, config ClientConfig) (*API, error) {
+	c, err := newClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
 	return &API{
 		Account:                 accountAPI{c},
 		AccountDiscovery:        accountDiscoveryAPI{c},
@@ -55,5 +59,6 @@ func New(ctx context.Context, config ClientConfig) *API {
 		Template:                templateAPI{c},
 		User:                    userAPI{c},
 		UserGroup:               userGroupAPI{c},
-	}
+		UserGroupMember:         userGroupMemberAPI{c},
+	}, nil
 }
@@ -0,0 +1,71 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// apiKeyCommand retrieves the API key from the output of an external command.
+//
+// The key is cached after the first successful run, and only refreshed when
+// the API rejects it.
+type apiKeyCommand struct {
+	Args []string
+
+	mu  sync.Mutex
+	key string
+}
+
+// Token returns the API key, running the command if it's not cached yet.
+func (c *apiKeyCommand) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.key == "" {
+		return c.run(ctx)
+	}
+	return c.key, nil
+}
+
+// Refresh runs the command again to get a new API key, unless the cached key
+// differs from the stale one, meaning it's already been refreshed by a
+// concurrent request.
+func (c *apiKeyCommand) Refresh(ctx context.Context, stale string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.key != stale {
+		return c.key, nil
+	}
+	return c.run(ctx)
+}
+
+func (c *apiKeyCommand) run(ctx context.Context) (string, error) {
+	if len(c.Args) == 0 {
+		return "", fmt.Errorf("no API key command provided")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.Args[0], c.Args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("API key command failed: %w: %s", err, msg)
+		}
+		return "", fmt.Errorf("API key command failed: %w", err)
+	}
+
+	key := strings.TrimSpace(stdout.String())
+	if key == "" {
+		return "", fmt.Errorf("API key command returned an empty key")
+	}
+	c.key = key
+	return key, nil
+}
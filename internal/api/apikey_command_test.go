@@ -0,0 +1,88 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCountingCommand returns an apiKeyCommand printing a different key on
+// each run ("key-1", "key-2", ...).
+func newCountingCommand(t *testing.T) *apiKeyCommand {
+	counter := path.Join(t.TempDir(), "counter")
+	script := `n=$(cat "$1" 2>/dev/null || echo 0); n=$((n+1)); echo $n > "$1"; echo "key-$n"`
+	return &apiKeyCommand{Args: []string{"sh", "-c", script, "sh", counter}}
+}
+
+func TestAPIKeyCommand_Token(t *testing.T) {
+	cmd := newCountingCommand(t)
+
+	key, err := cmd.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key)
+
+	// the key is cached
+	key, err = cmd.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key)
+}
+
+func TestAPIKeyCommand_Refresh(t *testing.T) {
+	cmd := newCountingCommand(t)
+
+	key, _ := cmd.Token(context.Background())
+	key, err := cmd.Refresh(context.Background(), key)
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", key)
+
+	// the key is not refreshed again if it's already changed
+	key, err = cmd.Refresh(context.Background(), "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", key)
+}
+
+func TestAPIKeyCommand_Failure(t *testing.T) {
+	cmd := &apiKeyCommand{Args: []string{"sh", "-c", "echo 'access denied' >&2; exit 1"}}
+
+	_, err := cmd.Token(context.Background())
+	assert.ErrorContains(t, err, "API key command failed: exit status 1: access denied")
+}
+
+func TestAPIKeyCommand_EmptyKey(t *testing.T) {
+	cmd := &apiKeyCommand{Args: []string{"sh", "-c", "echo"}}
+
+	_, err := cmd.Token(context.Background())
+	assert.ErrorContains(t, err, "API key command returned an empty key")
+}
+
+func TestAuthTransport_CommandRefreshedOnUnauthorized(t *testing.T) {
+	var auths, bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		auths = append(auths, auth)
+		bodies = append(bodies, string(body))
+		if auth != "Bearer key-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &authTransport{Tokens: newCountingCommand(t), Base: http.DefaultTransport}}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer key-1", "Bearer key-2"}, auths)
+	assert.Equal(t, []string{`{}`, `{}`}, bodies)
+}
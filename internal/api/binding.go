@@ -99,6 +99,36 @@ type BindingExecutionConfigResourceLimitsPolicyOverrides struct {
 	PolicyName string                              `graphql:"policyName" json:"policyName"`
 }
 
+// BindingsFilter defines filters for listing bindings. Only set fields are
+// used for filtering.
+type BindingsFilter struct {
+	AccountGroupUUID     string
+	PolicyCollectionUUID string
+	AutoDeploy           *bool
+	DryRun               *bool
+	System               *bool
+}
+
+func (f BindingsFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.AccountGroupUUID != "" {
+		filters = append(filters, newExactMatchFilter("account-group-uuid", f.AccountGroupUUID))
+	}
+	if f.PolicyCollectionUUID != "" {
+		filters = append(filters, newExactMatchFilter("policy-collection-uuid", f.PolicyCollectionUUID))
+	}
+	if f.AutoDeploy != nil {
+		filters = append(filters, newExactMatchFilter("auto-deploy", *f.AutoDeploy))
+	}
+	if f.DryRun != nil {
+		filters = append(filters, newExactMatchFilter("dry-run", *f.DryRun))
+	}
+	if f.System != nil {
+		filters = append(filters, newExactMatchFilter("system", *f.System))
+	}
+	return newAllOfFilter(filters)
+}
+
 // BindingCreateInput is the input for creating a binding.
 type BindingCreateInput struct {
 	Name                 string                 `json:"name"`
@@ -151,6 +181,24 @@ func (a bindingAPI) Read(ctx context.Context, uuid string, name string) (*Bindin
 	return &query.Binding, nil
 }
 
+// List returns bindings matching the filter.
+func (a bindingAPI) List(ctx context.Context, filter BindingsFilter) ([]Binding, error) {
+	return paginate(ctx, func(cursor string) (*connection[Binding], error) {
+		var query struct {
+			Bindings connection[Binding] `graphql:"bindings(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      a.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.Bindings, nil
+	})
+}
+
 // Create creates a binding.
 func (a bindingAPI) Create(ctx context.Context, i BindingCreateInput) (*Binding, error) {
 	var mutation struct {
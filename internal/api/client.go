@@ -10,7 +10,7 @@ import (
 	"io"
 	"net/http"
 	"os"
-	"strings"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -19,50 +19,121 @@ import (
 
 // ClientConfig is the configuration for the API client.
 type ClientConfig struct {
-	Endpoint string
-	APIKey   string
-	Version  string
-	PageSize int
+	Endpoint     string
+	APIKey       string
+	Version      string
+	PageSize     int
+	MaxRetries   int
+	RetryMinWait time.Duration
+	RetryMaxWait time.Duration
+	// RateLimit is the maximum number of requests per second, 0 for no limit.
+	RateLimit float64
+	// MaxConcurrentRequests is the maximum number of requests in flight, 0 for no limit.
+	MaxConcurrentRequests int
+	// RequestTimeout is the maximum duration of each API call, including
+	// retries and throttling, 0 for no timeout. It's not applied to calls
+	// whose context already has a deadline.
+	RequestTimeout time.Duration
+	// APIKeyCommand is a command returning the API key, used in place of
+	// APIKey when set.
+	APIKeyCommand []string
+	// TokenURL is the OAuth2 token endpoint used to get new tokens, either
+	// via the client credentials grant if ClientSecret is set, or via the
+	// refresh token grant if RefreshToken is set.
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	// ProxyURL is the URL of the proxy for API requests. If not set, the
+	// proxy is configured from the environment.
+	ProxyURL string
+	// CACertFile and CACertPEM provide additional CA certificates to trust,
+	// either from a file or as PEM content.
+	CACertFile string
+	CACertPEM  string
+	// ClientCert and ClientKey are the PEM-encoded certificate and key for
+	// TLS client authentication.
+	ClientCert string
+	ClientKey  string
+	// InsecureSkipVerify disables verification of the server certificate.
+	InsecureSkipVerify bool
 }
 
 // client is the wrapper for the GraphQL client.
 type client struct {
 	c        *graphql.Client
 	pageSize int
+	timeout  time.Duration
 }
 
 // Query makes a GraphQL query call.
 func (c *client) Query(ctx context.Context, q any, variables map[string]any) error {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
 	err := c.c.Query(ctx, q, variables)
 	if err != nil {
-		return newAPIError(err)
+		return fromClientError(err)
 	}
 	return nil
 }
 
 // Mutate makes a GraphQL mutation call.
 func (c *client) Mutate(ctx context.Context, m any, variables map[string]any) error {
-	err := c.c.Mutate(ctx, m, variables)
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	err := c.c.Mutate(withMutation(ctx), m, variables)
 	if err != nil {
-		return newAPIError(err)
+		return fromClientError(err)
 	}
 	return nil
 }
 
+// withTimeout returns a context that is canceled after the configured request
+// timeout, if any.
+//
+// Contexts that already have a deadline, like those for operations with a
+// configurable timeout, are not further limited.
+func (c *client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout <= 0 {
+		return ctx, func() {}
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
 // newClient returns a configured graphql Client.
-func newClient(ctx context.Context, config ClientConfig) *client {
+func newClient(ctx context.Context, config ClientConfig) (*client, error) {
 	tfLog := hclog.LevelFromString(os.Getenv("TF_LOG"))
 	logBody := tfLog == hclog.Debug || tfLog == hclog.Trace
 
+	baseTransport, err := newBaseTransport(config)
+	if err != nil {
+		return nil, err
+	}
+
 	httpClient := &http.Client{
 		Transport: &errorTransport{
-			Base: &authTransport{
-				APIKey:  config.APIKey,
-				Version: config.Version,
-				Base: &logTransport{
-					Ctx:     ctx,
-					Base:    http.DefaultTransport,
-					LogBody: logBody,
+			Base: &retryTransport{
+				Ctx:        ctx,
+				MaxRetries: config.MaxRetries,
+				MinWait:    config.RetryMinWait,
+				MaxWait:    config.RetryMaxWait,
+				Base: &authTransport{
+					APIKey:  config.APIKey,
+					Tokens:  newTokenSource(config, baseTransport),
+					Version: config.Version,
+					Base: &limiterTransport{
+						Limiter: newRequestLimiter(config.RateLimit, config.MaxConcurrentRequests),
+						Base: &logTransport{
+							Ctx:     ctx,
+							Base:    baseTransport,
+							LogBody: logBody,
+						},
+					},
 				},
 			},
 		},
@@ -70,21 +141,80 @@ func newClient(ctx context.Context, config ClientConfig) *client {
 	return &client{
 		c:        graphql.NewClient(config.Endpoint, httpClient),
 		pageSize: config.PageSize,
+		timeout:  config.RequestTimeout,
+	}, nil
+}
+
+// newTokenSource returns the tokenSource for the configured credentials, or
+// nil if a static API key is used.
+func newTokenSource(config ClientConfig, transport http.RoundTripper) tokenSource {
+	switch {
+	case len(config.APIKeyCommand) > 0:
+		return &apiKeyCommand{Args: config.APIKeyCommand}
+	case config.TokenURL != "" && (config.ClientSecret != "" || config.RefreshToken != ""):
+		return newOAuthTokenSource(config, config.APIKey, &http.Client{Transport: transport})
+	default:
+		return nil
 	}
 }
 
 // authTransport is an http.Transport that adds authorization header.
+//
+// If a token source is set, the token is retrieved from it, and refreshed
+// when the API rejects it, retrying the request once.
+//
+// It wraps limiterTransport, so that fetching tokens or running the API key
+// command doesn't hold a limiter slot.
 type authTransport struct {
 	APIKey  string
+	Tokens  tokenSource
 	Version string
 	Base    http.RoundTripper
 }
 
 func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	if t.APIKey == "" {
-		return nil, fmt.Errorf("no API key provided")
+	if t.Tokens == nil {
+		if t.APIKey == "" {
+			return nil, fmt.Errorf("no API key provided")
+		}
+		return t.do(req, t.APIKey)
 	}
-	req.Header.Set("Authorization", "Bearer "+t.APIKey)
+
+	// Read the body upfront since it might need to be sent again
+	hasBody := req.Body != nil && req.Body != http.NoBody
+	body, err := decodeRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := req.Context()
+	key, err := t.Tokens.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.do(req.Clone(ctx), key)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// drain the body so that the connection can be reused, and close it
+	// before refreshing so that the limiter slot is not held meanwhile
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	newKey, err := t.Tokens.Refresh(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(ctx)
+	if hasBody {
+		r.Body = io.NopCloser(bytes.NewReader([]byte(body)))
+	}
+	return t.do(r, newKey)
+}
+
+func (t *authTransport) do(req *http.Request, apiKey string) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+apiKey)
 	req.Header.Set("User-Agent", "terraform-provider-stacklet/"+t.Version)
 	return t.Base.RoundTrip(req)
 }
@@ -165,15 +295,16 @@ func (t *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	var payload struct {
 		Errors []struct {
-			Message string `json:"message"`
+			Message    string         `json:"message"`
+			Extensions map[string]any `json:"extensions"`
 		} `json:"errors"`
 	}
 	if json.Unmarshal([]byte(content), &payload) == nil && len(payload.Errors) > 0 {
-		msgs := make([]string, len(payload.Errors))
+		errs := make([]apiError, len(payload.Errors))
 		for i, e := range payload.Errors {
-			msgs[i] = e.Message
+			errs[i] = fromGraphQLError(e.Message, e.Extensions)
 		}
-		return nil, apiError{Kind: "API Error", Detail: strings.Join(msgs, "\n")}
+		return nil, newAPIErrors(errs)
 	}
 
 	return resp, nil
@@ -3,12 +3,15 @@
 package api
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUserAgentHeader(t *testing.T) {
@@ -110,3 +113,54 @@ func TestErrorTransport_NonBadRequest(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestClient_RequestTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	c, err := newClient(context.Background(), ClientConfig{
+		Endpoint:       server.URL,
+		APIKey:         "test-api-key",
+		RequestTimeout: 10 * time.Millisecond,
+	})
+	var q struct {
+		Platform struct {
+			ID string
+		}
+	}
+	require.NoError(t, err)
+	err = c.Query(context.Background(), &q, nil)
+
+	assert.ErrorContains(t, err, context.DeadlineExceeded.Error())
+}
+
+func TestClient_RequestTimeoutContextDeadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"data":{"platform":{"id":"platform-id"}}}`))
+	}))
+	defer server.Close()
+
+	c, err := newClient(context.Background(), ClientConfig{
+		Endpoint:       server.URL,
+		APIKey:         "test-api-key",
+		RequestTimeout: 10 * time.Millisecond,
+	})
+	var q struct {
+		Platform struct {
+			ID string
+		}
+	}
+	require.NoError(t, err)
+	// the context deadline takes precedence over the request timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	err = c.Query(ctx, &q, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "platform-id", q.Platform.ID)
+}
@@ -4,28 +4,126 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hasura/go-graphql-client"
 )
 
 // apiError represent an error interacting with the API.
 type apiError struct {
 	Kind   string
 	Detail string
+	// Code is the error code from the API, if any.
+	Code string
+	// Path is the path of the API field the error refers to, if known.
+	Path []any
+
+	// fieldAttributes maps API input fields to attribute names
+	fieldAttributes map[string]string
+	// typed is the error of a known type for the error code or kind, if any
+	typed hintedError
 }
 
 // Error returns the error summary message.
 func (e apiError) Summary() string {
+	if e.typed != nil {
+		return e.typed.Summary()
+	}
 	return e.Kind
 }
 
 // Error returns the error message.
 func (e apiError) Error() string {
+	if e.typed != nil {
+		return e.typed.Error()
+	}
 	return e.Detail
 }
 
+// Unwrap returns the error of a known type for the error, if any.
+func (e apiError) Unwrap() error {
+	if e.typed == nil {
+		return nil
+	}
+	return e.typed
+}
+
+// AttributePath returns the path of the Terraform attribute the error refers
+// to, or an empty path if unknown.
+func (e apiError) AttributePath() path.Path {
+	return attributePath(e.Path, e.fieldAttributes)
+}
+
+// WithFieldAttributes returns a copy of the error using the provided mapping
+// from API input fields to Terraform attributes to find the attribute path.
+func (e apiError) WithFieldAttributes(fields map[string]string) error {
+	e.fieldAttributes = fields
+	return e
+}
+
+// apiErrors represents multiple errors returned by the API for a request.
+type apiErrors []apiError
+
+// Summary returns the error summary.
+func (e apiErrors) Summary() string {
+	return e[0].Summary()
+}
+
+// Error returns the error message, with all errors on separate lines.
+func (e apiErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the single errors.
+func (e apiErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// WithFieldAttributes returns a copy of the errors using the provided mapping
+// from API input fields to Terraform attributes to find attribute paths.
+func (e apiErrors) WithFieldAttributes(fields map[string]string) error {
+	errs := make(apiErrors, len(e))
+	for i, err := range e {
+		err.fieldAttributes = fields
+		errs[i] = err
+	}
+	return errs
+}
+
+// newAPIErrors returns an error from a list of apiErrors, or a single apiError
+// if the list has one element.
+//
+// Errors of a known type (e.g. PermissionDenied) wrap the typed error, so that
+// it can be found with errors.As.
+func newAPIErrors(errs []apiError) error {
+	for i, e := range errs {
+		errs[i].typed = newTypedError(e.Code, e.Detail)
+		if errs[i].typed == nil {
+			errs[i].typed = newTypedError(e.Kind, e.Detail)
+		}
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return apiErrors(errs)
+}
+
 // newAPIError returns an apiError from an error.
 func newAPIError(err error) apiError {
 	var urlErr *url.Error
@@ -35,6 +133,66 @@ func newAPIError(err error) apiError {
 	return apiError{Kind: "API Error", Detail: err.Error()}
 }
 
+// fromClientError returns an error from a GraphQL client call, with an
+// apiError for each error returned in the GraphQL response.
+func fromClientError(err error) error {
+	// errors from errorTransport
+	var multiErr apiErrors
+	if errors.As(err, &multiErr) {
+		return multiErr
+	}
+	var singleErr apiError
+	if errors.As(err, &singleErr) {
+		return singleErr
+	}
+	var typedErr hintedError
+	if errors.As(err, &typedErr) {
+		return typedErr
+	}
+
+	var networkErr graphql.NetworkError
+	if errors.As(err, &networkErr) {
+		if typed := fromHTTPStatus(networkErr.StatusCode(), networkErr.Body()); typed != nil {
+			return typed
+		}
+	}
+
+	var gqlErrs graphql.Errors
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) == 0 {
+		return newAPIError(err)
+	}
+
+	errs := make([]apiError, 0, len(gqlErrs))
+	for _, e := range gqlErrs {
+		if errors.Unwrap(e) != nil {
+			// not an error from the GraphQL response, but from performing the request
+			return newAPIError(err)
+		}
+		errs = append(errs, fromGraphQLError(e.Message, e.Extensions))
+	}
+	return newAPIErrors(errs)
+}
+
+// fromGraphQLError returns an apiError for an error in a GraphQL response.
+//
+// The input field the error refers to is looked up from the "path" or "field"
+// extensions, if present. The response path of the error is not used, as it
+// refers to fields of the result rather than of the input.
+func fromGraphQLError(message string, extensions map[string]any) apiError {
+	e := apiError{Kind: "API Error", Detail: message}
+	e.Code, _ = extensions["code"].(string)
+	switch {
+	case extensions["path"] != nil:
+		e.Path, _ = extensions["path"].([]any)
+	case extensions["field"] != nil:
+		field, _ := extensions["field"].(string)
+		for _, step := range strings.Split(field, ".") {
+			e.Path = append(e.Path, step)
+		}
+	}
+	return e
+}
+
 // NotFound represents an error raised when an API resource is not found.
 type NotFound struct {
 	Message string
@@ -50,19 +208,187 @@ func (e NotFound) Error() string {
 	return e.Message
 }
 
+// hintedError is an error of a known type, with a hint on how to address it.
+type hintedError interface {
+	error
+	Summary() string
+	hint() string
+}
+
+// PermissionDenied represents an error raised when the credentials used by
+// the provider lack the permission for an operation.
+type PermissionDenied struct {
+	Message string
+}
+
+// Summary returns the error summary.
+func (e PermissionDenied) Summary() string {
+	return "Permission Denied"
+}
+
+// Error returns the error message.
+func (e PermissionDenied) Error() string {
+	return withHint(e.Message, e.hint())
+}
+
+func (e PermissionDenied) hint() string {
+	return "The user or service account for the API key used by the provider doesn't have a role granting this operation. " +
+		"Assign a role with the required permission on the target (e.g. an account group or policy collection) " +
+		"with a stacklet_role_assignment resource, using the role_assignment_target attribute of the target as target, " +
+		"or use credentials with broader access."
+}
+
+// Unauthenticated represents an error raised when the API rejects the
+// credentials used by the provider.
+type Unauthenticated struct {
+	Message string
+}
+
+// Summary returns the error summary.
+func (e Unauthenticated) Summary() string {
+	return "Authentication Failed"
+}
+
+// Error returns the error message.
+func (e Unauthenticated) Error() string {
+	return withHint(e.Message, e.hint())
+}
+
+func (e Unauthenticated) hint() string {
+	return "The API rejected the credentials used by the provider. " +
+		"Check that the API key (from api_key, api_key_command or the STACKLET_API_KEY environment variable) is valid and not expired, " +
+		"or login again via the stacklet-admin CLI."
+}
+
+// Conflict represents an error raised when an operation conflicts with the
+// current state of a resource.
+type Conflict struct {
+	Message string
+}
+
+// Summary returns the error summary.
+func (e Conflict) Summary() string {
+	return "Conflict"
+}
+
+// Error returns the error message.
+func (e Conflict) Error() string {
+	return withHint(e.Message, e.hint())
+}
+
+func (e Conflict) hint() string {
+	return "The resource already exists or was changed concurrently. " +
+		"If it's already defined in Stacklet, import it with terraform import, otherwise retry the operation."
+}
+
+// RateLimited represents an error raised when the API throttles requests from
+// the provider.
+type RateLimited struct {
+	Message string
+}
+
+// Summary returns the error summary.
+func (e RateLimited) Summary() string {
+	return "Rate Limited"
+}
+
+// Error returns the error message.
+func (e RateLimited) Error() string {
+	return withHint(e.Message, e.hint())
+}
+
+func (e RateLimited) hint() string {
+	return "The API is throttling requests from the provider. " +
+		"Reduce Terraform parallelism (-parallelism flag), or set rate_limit or max_concurrent_requests in the provider configuration. " +
+		"Retries with backoff can be tuned via max_retries, retry_min_wait and retry_max_wait."
+}
+
+// withHint returns an error message followed by a hint.
+func withHint(message string, hint string) string {
+	if message == "" {
+		return hint
+	}
+	return message + "\n\n" + hint
+}
+
+// typedErrorCodes maps normalized error codes or problem kinds to
+// constructors for typed errors.
+var typedErrorCodes = map[string]func(string) hintedError{
+	"PERMISSIONDENIED": func(m string) hintedError { return PermissionDenied{m} },
+	"FORBIDDEN":        func(m string) hintedError { return PermissionDenied{m} },
+	"ACCESSDENIED":     func(m string) hintedError { return PermissionDenied{m} },
+	"UNAUTHENTICATED":  func(m string) hintedError { return Unauthenticated{m} },
+	"UNAUTHORIZED":     func(m string) hintedError { return Unauthenticated{m} },
+	"CONFLICT":         func(m string) hintedError { return Conflict{m} },
+	"ALREADYEXISTS":    func(m string) hintedError { return Conflict{m} },
+	"RATELIMITED":      func(m string) hintedError { return RateLimited{m} },
+	"TOOMANYREQUESTS":  func(m string) hintedError { return RateLimited{m} },
+	"THROTTLED":        func(m string) hintedError { return RateLimited{m} },
+}
+
+// newTypedError returns a typed error for an error code or problem kind
+// (e.g. "PERMISSION_DENIED" or "PermissionDenied"), or nil if the code is not
+// of a known type.
+func newTypedError(code string, message string) hintedError {
+	normalized := strings.ToUpper(strings.NewReplacer("_", "", "-", "", " ", "").Replace(code))
+	if newErr, ok := typedErrorCodes[normalized]; ok {
+		return newErr(message)
+	}
+	return nil
+}
+
+// fromHTTPStatus returns a typed error for an HTTP response status, or nil if
+// the status doesn't match a known type.
+func fromHTTPStatus(status int, body string) hintedError {
+	var code string
+	switch status {
+	case http.StatusUnauthorized:
+		code = "UNAUTHENTICATED"
+	case http.StatusForbidden:
+		code = "PERMISSION_DENIED"
+	case http.StatusConflict:
+		code = "CONFLICT"
+	case http.StatusTooManyRequests:
+		code = "RATE_LIMITED"
+	default:
+		return nil
+	}
+
+	message := http.StatusText(status)
+	var payload struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal([]byte(body), &payload) == nil && len(payload.Errors) > 0 {
+		msgs := make([]string, len(payload.Errors))
+		for i, e := range payload.Errors {
+			msgs[i] = e.Message
+		}
+		message = strings.Join(msgs, "\n")
+	}
+	return newTypedError(code, message)
+}
+
 // fromProblems returns an error from a list of API problems.
+//
+// If multiple problems are reported, the returned error holds all of them.
 func fromProblems(ctx context.Context, problems []problem) error {
 	if len(problems) == 0 {
 		return nil
 	}
-	for _, problem := range problems[1:] {
+	for _, problem := range problems {
 		info := map[string]any{"kind": problem.Kind, "message": problem.Message}
-		tflog.Error(ctx, "discarding additional error", info)
+		tflog.Debug(ctx, "API returned problem", info)
 	}
 	if problems[0].Kind == "NotFound" {
 		return NotFound{problems[0].Message}
 	}
-	return apiError{Kind: problems[0].Kind, Detail: problems[0].Message}
+	errs := make([]apiError, len(problems))
+	for i, problem := range problems {
+		errs[i] = apiError{Kind: problem.Kind, Detail: problem.Message}
+	}
+	return newAPIErrors(errs)
 }
 
 // problem contains the details for an API query error.
@@ -70,3 +396,60 @@ type problem struct {
 	Kind    string `graphql:"__typename"`
 	Message string
 }
+
+// attributePath converts the path of an API input field to a Terraform
+// attribute path. A leading "input" step for the mutation input is skipped.
+//
+// Field names are looked up in fields by their dot-separated path (excluding
+// list indexes). A field mapped to an empty name is skipped, and list indexes
+// at the start of the path are ignored. The path stops at the first field
+// that isn't mapped, so it's empty unless the top-level field is mapped.
+func attributePath(apiPath []any, fields map[string]string) path.Path {
+	if len(apiPath) > 0 && apiPath[0] == "input" {
+		apiPath = apiPath[1:]
+	}
+
+	p := path.Empty()
+	names := make([]string, 0, len(apiPath))
+	for _, step := range apiPath {
+		if index, ok := pathIndex(step); ok {
+			if len(p.Steps()) > 0 {
+				p = p.AtListIndex(index)
+			}
+			continue
+		}
+
+		names = append(names, fmt.Sprint(step))
+		attr, ok := fields[strings.Join(names, ".")]
+		if !ok {
+			break
+		}
+		if attr == "" {
+			continue
+		}
+		for _, attrName := range strings.Split(attr, ".") {
+			if len(p.Steps()) == 0 {
+				p = path.Root(attrName)
+			} else {
+				p = p.AtName(attrName)
+			}
+		}
+	}
+	return p
+}
+
+// pathIndex returns the list index for a step of an API field path, and
+// whether the step is an index.
+func pathIndex(step any) (int, bool) {
+	switch s := step.(type) {
+	case float64:
+		return int(s), true
+	case int:
+		return s, true
+	case string:
+		if n, err := strconv.Atoi(s); err == nil {
+			return n, true
+		}
+	}
+	return 0, false
+}
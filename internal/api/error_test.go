@@ -3,11 +3,16 @@
 package api
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewAPIError_URLError(t *testing.T) {
@@ -28,3 +33,278 @@ func TestNewAPIError_PlainError(t *testing.T) {
 	assert.Equal(t, "API Error", err.Summary())
 	assert.Equal(t, "something went wrong", err.Error())
 }
+
+func TestFromProblems(t *testing.T) {
+	assert.NoError(t, fromProblems(context.Background(), nil))
+
+	err := fromProblems(context.Background(), []problem{{Kind: "NotFound", Message: "not here"}})
+	assert.Equal(t, NotFound{"not here"}, err)
+
+	err = fromProblems(context.Background(), []problem{{Kind: "ValidationError", Message: "bad value"}})
+	assert.Equal(t, apiError{Kind: "ValidationError", Detail: "bad value"}, err)
+
+	err = fromProblems(context.Background(), []problem{
+		{Kind: "ValidationError", Message: "bad value"},
+		{Kind: "InvalidInput", Message: "bad input"},
+	})
+	assert.Equal(t, apiErrors{
+		{Kind: "ValidationError", Detail: "bad value"},
+		{Kind: "InvalidInput", Detail: "bad input"},
+	}, err)
+	assert.EqualError(t, err, "bad value\nbad input")
+}
+
+func TestFromGraphQLError(t *testing.T) {
+	err := fromGraphQLError("bad", map[string]any{"path": []any{"input", "deliverySettings", float64(1), "recipients"}})
+	assert.Equal(t, []any{"input", "deliverySettings", float64(1), "recipients"}, err.Path)
+
+	err = fromGraphQLError("bad", map[string]any{"field": "input.emailSettings.0.subject"})
+	assert.Equal(t, []any{"input", "emailSettings", "0", "subject"}, err.Path)
+
+	err = fromGraphQLError("bad", map[string]any{"code": "NOT_FOUND"})
+	assert.Nil(t, err.Path)
+	assert.Equal(t, "NOT_FOUND", err.Code)
+	assert.Equal(t, "API Error", err.Summary())
+	assert.Equal(t, "bad", err.Error())
+}
+
+func TestFromGraphQLError_NoAttributeWithoutMapping(t *testing.T) {
+	err := fromGraphQLError("bad", map[string]any{"field": "input.emailSettings.0.subject"})
+	assert.Equal(t, path.Empty(), err.AttributePath())
+}
+
+func TestAttributePath(t *testing.T) {
+	assert.Equal(t, path.Empty(), attributePath(nil, nil))
+	assert.Equal(t, path.Empty(), attributePath([]any{"input"}, nil))
+	assert.Equal(t, path.Empty(), attributePath([]any{"cronTimezone"}, nil))
+	assert.Equal(t, path.Empty(), attributePath([]any{"items", "2"}, nil))
+}
+
+func TestAttributePath_FieldAttributes(t *testing.T) {
+	fields := map[string]string{
+		"name":                                      "name",
+		"items":                                     "items",
+		"provider":                                  "cloud_provider",
+		"executionConfig":                           "",
+		"executionConfig.dryRun":                    "dry_run",
+		"executionConfig.dryRun.default":            "",
+		"reportGroups":                              "",
+		"reportGroups.emailSettings":                "email_delivery_settings",
+		"reportGroups.emailSettings.fromEmail":      "from",
+		"reportGroups.emailSettings.recipients":     "recipients",
+		"reportGroups.emailSettings.recipients.tag": "tag",
+		"repositoryUUID":                            "dynamic_config.repository_uuid",
+	}
+
+	assert.Equal(t, path.Root("name"), attributePath([]any{0, "name"}, fields))
+	assert.Equal(t, path.Root("items").AtListIndex(2), attributePath([]any{"items", "2"}, fields))
+	assert.Equal(t, path.Root("cloud_provider"), attributePath([]any{"input", "provider"}, fields))
+	assert.Equal(t, path.Root("dry_run"), attributePath([]any{"executionConfig", "dryRun", "default"}, fields))
+	assert.Equal(
+		t,
+		path.Root("email_delivery_settings").AtListIndex(1).AtName("recipients").AtListIndex(0).AtName("tag"),
+		attributePath([]any{"reportGroups", 0, "emailSettings", 1, "recipients", 0, "tag"}, fields),
+	)
+	assert.Equal(t, path.Root("dynamic_config").AtName("repository_uuid"), attributePath([]any{"repositoryUUID"}, fields))
+}
+
+func TestAttributePath_UnmappedField(t *testing.T) {
+	fields := map[string]string{
+		"provider":                   "cloud_provider",
+		"executionConfig":            "",
+		"reportGroups":               "",
+		"reportGroups.emailSettings": "email_delivery_settings",
+	}
+
+	// unmapped fields don't refer to an attribute
+	assert.Equal(t, path.Empty(), attributePath([]any{"input", "cronTimezone"}, fields))
+	assert.Equal(t, path.Empty(), attributePath([]any{"executionConfig", "dryRun"}, fields))
+	// nested unmapped fields refer to the closest mapped attribute
+	assert.Equal(
+		t,
+		path.Root("email_delivery_settings").AtListIndex(1),
+		attributePath([]any{"reportGroups", 0, "emailSettings", 1, "recipients", 0, "eventOwner"}, fields),
+	)
+}
+
+func TestAPIError_WithFieldAttributes(t *testing.T) {
+	fields := map[string]string{"provider": "cloud_provider"}
+
+	err := apiError{Kind: "API Error", Detail: "bad", Path: []any{"provider"}}.WithFieldAttributes(fields)
+	attrErr, ok := err.(interface{ AttributePath() path.Path })
+	require.True(t, ok)
+	assert.Equal(t, path.Root("cloud_provider"), attrErr.AttributePath())
+
+	err = apiErrors{{Kind: "API Error", Detail: "bad", Path: []any{"provider"}}}.WithFieldAttributes(fields)
+	multiErr, ok := err.(apiErrors)
+	require.True(t, ok)
+	assert.Equal(t, path.Root("cloud_provider"), multiErr[0].AttributePath())
+}
+
+func TestFromClientError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errors":[
+			{"message":"first problem","path":["platform","name"]},
+			{"message":"second problem"}
+		]}`))
+	}))
+	defer server.Close()
+
+	c, err := newClient(context.Background(), ClientConfig{Endpoint: server.URL, APIKey: "test-api-key"})
+	require.NoError(t, err)
+	var q struct {
+		Platform struct {
+			Name string
+		}
+	}
+	err = c.Query(context.Background(), &q, nil)
+
+	assert.Equal(t, apiErrors{
+		{Kind: "API Error", Detail: "first problem"},
+		{Kind: "API Error", Detail: "second problem"},
+	}, err)
+}
+
+func TestFromClientError_BadRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"field not found"},{"message":"invalid type"}]}`))
+	}))
+	defer server.Close()
+
+	c, err := newClient(context.Background(), ClientConfig{Endpoint: server.URL, APIKey: "test-api-key"})
+	require.NoError(t, err)
+	var q struct {
+		Platform struct {
+			Name string
+		}
+	}
+	err = c.Query(context.Background(), &q, nil)
+
+	assert.Equal(t, apiErrors{
+		{Kind: "API Error", Detail: "field not found"},
+		{Kind: "API Error", Detail: "invalid type"},
+	}, err)
+}
+
+func TestNewTypedError(t *testing.T) {
+	assert.Equal(t, PermissionDenied{"denied"}, newTypedError("PERMISSION_DENIED", "denied"))
+	assert.Equal(t, PermissionDenied{"denied"}, newTypedError("Forbidden", "denied"))
+	assert.Equal(t, Unauthenticated{"who?"}, newTypedError("UNAUTHENTICATED", "who?"))
+	assert.Equal(t, Conflict{"exists"}, newTypedError("AlreadyExists", "exists"))
+	assert.Equal(t, RateLimited{"slow down"}, newTypedError("too-many-requests", "slow down"))
+	assert.Nil(t, newTypedError("ValidationError", "bad"))
+	assert.Nil(t, newTypedError("", "bad"))
+}
+
+func TestTypedErrors(t *testing.T) {
+	err := PermissionDenied{"Not allowed to update account group"}
+	assert.Equal(t, "Permission Denied", err.Summary())
+	assert.Contains(t, err.Error(), "Not allowed to update account group\n\n")
+	assert.Contains(t, err.Error(), "stacklet_role_assignment")
+
+	assert.Equal(t, "Authentication Failed", Unauthenticated{}.Summary())
+	assert.Contains(t, Unauthenticated{}.Error(), "stacklet-admin")
+	assert.Equal(t, "Conflict", Conflict{}.Summary())
+	assert.Contains(t, Conflict{}.Error(), "terraform import")
+	assert.Equal(t, "Rate Limited", RateLimited{}.Summary())
+	assert.Contains(t, RateLimited{}.Error(), "rate_limit")
+}
+
+func TestFromProblems_Typed(t *testing.T) {
+	err := fromProblems(context.Background(), []problem{
+		{Kind: "ValidationError", Message: "bad value"},
+		{Kind: "PermissionDenied", Message: "not allowed"},
+	})
+
+	// all problems are kept, with the typed error attached to its own
+	multiErr, ok := err.(apiErrors)
+	require.True(t, ok)
+	require.Len(t, multiErr, 2)
+	assert.Equal(t, "ValidationError", multiErr[0].Summary())
+	assert.Equal(t, "bad value", multiErr[0].Error())
+	assert.Equal(t, "Permission Denied", multiErr[1].Summary())
+	assert.Equal(t, PermissionDenied{"not allowed"}.Error(), multiErr[1].Error())
+
+	var permErr PermissionDenied
+	require.ErrorAs(t, err, &permErr)
+	assert.Equal(t, PermissionDenied{"not allowed"}, permErr)
+}
+
+func TestNewAPIErrors_TypedKeepsAttributePath(t *testing.T) {
+	fields := map[string]string{"name": "name", "provider": "cloud_provider"}
+	err := newAPIErrors([]apiError{
+		{Kind: "API Error", Code: "FORBIDDEN", Detail: "not allowed", Path: []any{"name"}},
+		{Kind: "API Error", Detail: "bad", Path: []any{"provider"}},
+	}).(apiErrors).WithFieldAttributes(fields)
+
+	multiErr, ok := err.(apiErrors)
+	require.True(t, ok)
+	require.Len(t, multiErr, 2)
+	assert.Equal(t, "Permission Denied", multiErr[0].Summary())
+	assert.Equal(t, path.Root("name"), multiErr[0].AttributePath())
+	assert.Equal(t, path.Root("cloud_provider"), multiErr[1].AttributePath())
+	assert.ErrorAs(t, err, new(PermissionDenied))
+}
+
+func TestFromClientError_Typed(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		expected error
+	}{
+		{
+			name:     "ExtensionsCode",
+			status:   http.StatusOK,
+			body:     `{"errors":[{"message":"not allowed","extensions":{"code":"FORBIDDEN"}}]}`,
+			expected: PermissionDenied{"not allowed"},
+		},
+		{
+			name:     "BadRequestExtensionsCode",
+			status:   http.StatusBadRequest,
+			body:     `{"errors":[{"message":"already exists","extensions":{"code":"CONFLICT"}}]}`,
+			expected: Conflict{"already exists"},
+		},
+		{
+			name:     "Unauthorized",
+			status:   http.StatusUnauthorized,
+			body:     `{"errors":[{"message":"invalid token"}]}`,
+			expected: Unauthenticated{"invalid token"},
+		},
+		{
+			name:     "Forbidden",
+			status:   http.StatusForbidden,
+			body:     `not json`,
+			expected: PermissionDenied{"Forbidden"},
+		},
+		{
+			name:     "TooManyRequests",
+			status:   http.StatusTooManyRequests,
+			expected: RateLimited{"Too Many Requests"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			c, err := newClient(context.Background(), ClientConfig{Endpoint: server.URL, APIKey: "test-api-key"})
+			require.NoError(t, err)
+			var q struct {
+				Platform struct {
+					Name string
+				}
+			}
+			err = c.Query(context.Background(), &q, nil)
+
+			var typed hintedError
+			require.ErrorAs(t, err, &typed)
+			assert.Equal(t, tc.expected, typed)
+		})
+	}
+}
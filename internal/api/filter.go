@@ -23,6 +23,14 @@ func (i filterElementInput) GetGraphQLType() string {
 	return "FilterElementInput"
 }
 
+// optionalFilterElementInput is a filterElementInput for optional query
+// variables, sent as null when no filter is set.
+type optionalFilterElementInput filterElementInput
+
+func (i *optionalFilterElementInput) GetGraphQLType() string {
+	return "FilterElementInput"
+}
+
 // filterValueInput is a filter for a single value.
 type filterValueInput struct {
 	Name     string `json:"name"`
@@ -68,3 +76,17 @@ func newCompositeFilter(filters []filterElementInput, operator filterBooleanOper
 		},
 	}
 }
+
+// newAllOfFilter returns an optional filter matching all the specified
+// filters, or nil if there are none.
+func newAllOfFilter(filters []filterElementInput) *optionalFilterElementInput {
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return (*optionalFilterElementInput)(&filters[0])
+	default:
+		filter := newCompositeFilter(filters, filterBooleanAND)
+		return (*optionalFilterElementInput)(&filter)
+	}
+}
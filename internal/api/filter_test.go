@@ -0,0 +1,191 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAllOfFilter(t *testing.T) {
+	assert.Nil(t, newAllOfFilter(nil))
+
+	single := newExactMatchFilter("name", "foo")
+	assert.Equal(t, (*optionalFilterElementInput)(&single), newAllOfFilter([]filterElementInput{single}))
+
+	other := newExactMatchFilter("provider", CloudProviderAWS)
+	assert.Equal(
+		t,
+		&optionalFilterElementInput{
+			Multiple: &filterBooleanOperationInput{
+				Operands: []filterElementInput{single, other},
+				Operator: filterBooleanAND,
+			},
+		},
+		newAllOfFilter([]filterElementInput{single, other}),
+	)
+}
+
+func TestOptionalFilterElementInput(t *testing.T) {
+	var filter *optionalFilterElementInput
+	assert.Equal(t, "FilterElementInput", filter.GetGraphQLType())
+
+	content, err := json.Marshal(filter)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(content))
+
+	filter = newAllOfFilter([]filterElementInput{newExactMatchFilter("name", "foo")})
+	content, err = json.Marshal(filter)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"single":{"name":"name","operator":"equals","value":"foo"}}`, string(content))
+}
+
+func TestAccountsFilter(t *testing.T) {
+	assert.Nil(t, AccountsFilter{}.filterElement())
+
+	active := false
+	filter := AccountsFilter{
+		Provider: CloudProviderAWS,
+		Email:    "ops@example.com",
+		Active:   &active,
+		Tags:     TagsList{{Key: "env", Value: "prod"}},
+	}
+	assert.Equal(
+		t,
+		[]filterElementInput{
+			newExactMatchFilter("provider", CloudProviderAWS),
+			newExactMatchFilter("email", "ops@example.com"),
+			newExactMatchFilter("active", false),
+			newExactMatchFilter("tag:env", "prod"),
+		},
+		filter.filterElement().Multiple.Operands,
+	)
+}
+
+func TestAccountGroupsFilter(t *testing.T) {
+	assert.Nil(t, AccountGroupsFilter{}.filterElement())
+
+	provider := newExactMatchFilter("provider", CloudProviderGCP)
+	filter := AccountGroupsFilter{Provider: CloudProviderGCP}
+	assert.Equal(t, (*optionalFilterElementInput)(&provider), filter.filterElement())
+}
+
+func TestBindingsFilter(t *testing.T) {
+	assert.Nil(t, BindingsFilter{}.filterElement())
+
+	autoDeploy, dryRun, system := true, false, false
+	filter := BindingsFilter{
+		AccountGroupUUID:     "ag-uuid",
+		PolicyCollectionUUID: "pc-uuid",
+		AutoDeploy:           &autoDeploy,
+		DryRun:               &dryRun,
+		System:               &system,
+	}
+	assert.Equal(
+		t,
+		[]filterElementInput{
+			newExactMatchFilter("account-group-uuid", "ag-uuid"),
+			newExactMatchFilter("policy-collection-uuid", "pc-uuid"),
+			newExactMatchFilter("auto-deploy", true),
+			newExactMatchFilter("dry-run", false),
+			newExactMatchFilter("system", false),
+		},
+		filter.filterElement().Multiple.Operands,
+	)
+}
+
+func TestPoliciesFilter(t *testing.T) {
+	assert.Nil(t, PoliciesFilter{}.filterElement())
+
+	system := true
+	filter := PoliciesFilter{
+		Provider: CloudProviderAWS,
+		Category: "cost",
+		Mode:     "pull",
+		System:   &system,
+		UUIDs:    []string{"uuid-1", "uuid-2"},
+	}
+	assert.Equal(
+		t,
+		[]filterElementInput{
+			newExactMatchFilter("provider", CloudProviderAWS),
+			newExactMatchFilter("category", "cost"),
+			newExactMatchFilter("mode", "pull"),
+			newExactMatchFilter("system", true),
+			newCompositeFilter(
+				[]filterElementInput{
+					newExactMatchFilter("uuid", "uuid-1"),
+					newExactMatchFilter("uuid", "uuid-2"),
+				},
+				filterBooleanOR,
+			),
+		},
+		filter.filterElement().Multiple.Operands,
+	)
+}
+
+func TestPolicyCollectionsFilter(t *testing.T) {
+	assert.Nil(t, PolicyCollectionsFilter{}.filterElement())
+
+	system, dynamic := false, true
+	filter := PolicyCollectionsFilter{
+		Provider:       CloudProviderAzure,
+		System:         &system,
+		Dynamic:        &dynamic,
+		RepositoryUUID: "repo-uuid",
+	}
+	assert.Equal(
+		t,
+		[]filterElementInput{
+			newExactMatchFilter("provider", CloudProviderAzure),
+			newExactMatchFilter("system", false),
+			newExactMatchFilter("is-dynamic", true),
+			newExactMatchFilter("repository-uuid", "repo-uuid"),
+		},
+		filter.filterElement().Multiple.Operands,
+	)
+}
+
+func TestUsersFilter(t *testing.T) {
+	assert.Nil(t, UsersFilter{}.filterElement())
+
+	active := true
+	filter := UsersFilter{Active: &active}
+	expected := newSimpleFilter("active", true)
+	assert.Equal(t, (*optionalFilterElementInput)(&expected), filter.filterElement())
+}
+
+func TestRolesFilter(t *testing.T) {
+	assert.Nil(t, RolesFilter{}.filterElement())
+
+	filter := RolesFilter{Permission: "binding:write"}
+	expected := newExactMatchFilter("permission", "binding:write")
+	assert.Equal(t, (*optionalFilterElementInput)(&expected), filter.filterElement())
+}
+
+func TestReportGroupsFilter(t *testing.T) {
+	assert.Nil(t, ReportGroupsFilter{}.filterElement())
+
+	enabled := true
+	filter := ReportGroupsFilter{Source: ReportSourceBinding, Enabled: &enabled, BindingUUID: "binding-uuid"}
+	assert.Equal(
+		t,
+		[]filterElementInput{
+			newExactMatchFilter("source", "BINDING"),
+			newExactMatchFilter("enabled", true),
+			newExactMatchFilter("binding-uuid", "binding-uuid"),
+		},
+		filter.filterElement().Multiple.Operands,
+	)
+}
+
+func TestTemplatesFilter(t *testing.T) {
+	assert.Nil(t, TemplatesFilter{}.filterElement())
+
+	filter := TemplatesFilter{Transport: "slack"}
+	expected := newExactMatchFilter("transport", "slack")
+	assert.Equal(t, (*optionalFilterElementInput)(&expected), filter.filterElement())
+}
@@ -0,0 +1,150 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter throttles API requests, limiting both their rate and the
+// number of requests in flight at the same time.
+//
+// Since all resources and data sources share the same client, this prevents
+// high Terraform parallelism from tripping throttling on the platform.
+type requestLimiter struct {
+	rate  *rate.Limiter // nil if requests rate is not limited
+	slots chan struct{} // nil if concurrent requests are not limited
+}
+
+// newRequestLimiter returns a requestLimiter allowing up to requestsPerSecond
+// requests per second, with at most maxConcurrent in flight. A zero value
+// disables the respective limit.
+func newRequestLimiter(requestsPerSecond float64, maxConcurrent int) *requestLimiter {
+	l := &requestLimiter{}
+	if requestsPerSecond > 0 {
+		l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+	}
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	return l
+}
+
+// acquire waits until a request can be performed, or the context is done.
+// On success, the returned function must be called once the request
+// completes.
+func (l *requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if err := l.acquireSlot(ctx); err != nil {
+		return nil, err
+	}
+	if err := l.waitRate(ctx); err != nil {
+		l.releaseSlot()
+		return nil, err
+	}
+	return l.releaseSlot, nil
+}
+
+func (l *requestLimiter) acquireSlot(ctx context.Context) error {
+	if l.slots == nil {
+		return nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	tflog.Debug(
+		ctx,
+		"Throttling API request, waiting for in-flight requests to complete",
+		map[string]any{"max_concurrent_requests": cap(l.slots)},
+	)
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l *requestLimiter) releaseSlot() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+func (l *requestLimiter) waitRate(ctx context.Context) error {
+	if l.rate == nil {
+		return nil
+	}
+
+	r := l.rate.Reserve()
+	delay := r.Delay()
+	if delay == 0 {
+		return nil
+	}
+
+	tflog.Debug(
+		ctx,
+		"Throttling API request, requests rate limit reached",
+		map[string]any{"rate_limit": float64(l.rate.Limit()), "wait": delay.String()},
+	)
+	timer := time.NewTimer(delay)
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		r.Cancel()
+		return ctx.Err()
+	}
+}
+
+// limiterTransport is an http.Transport that throttles requests with a
+// requestLimiter.
+//
+// It's wrapped by retryTransport and authTransport, so that each attempt is
+// throttled separately, and the slot is not held while waiting to retry or
+// while credentials are retrieved. The slot is released once the response body
+// is closed.
+type limiterTransport struct {
+	Base    http.RoundTripper
+	Limiter *requestLimiter
+}
+
+func (t *limiterTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.Limiter.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnCloseBody is a response body that releases the limiter slot when
+// closed.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,220 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLimiter_NoLimits(t *testing.T) {
+	l := newRequestLimiter(0, 0)
+
+	for range 100 {
+		_, err := l.acquire(context.Background())
+		require.NoError(t, err)
+	}
+}
+
+func TestRequestLimiter_MaxConcurrent(t *testing.T) {
+	l := newRequestLimiter(0, 2)
+
+	var inFlight, maxInFlight atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			release, err := l.acquire(context.Background())
+			assert.NoError(t, err)
+			defer release()
+
+			current := inFlight.Add(1)
+			for {
+				prev := maxInFlight.Load()
+				if current <= prev || maxInFlight.CompareAndSwap(prev, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			inFlight.Add(-1)
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestRequestLimiter_MaxConcurrentContextDone(t *testing.T) {
+	l := newRequestLimiter(0, 1)
+
+	release, err := l.acquire(context.Background())
+	require.NoError(t, err)
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRequestLimiter_RateLimit(t *testing.T) {
+	l := newRequestLimiter(100, 0)
+
+	start := time.Now()
+	for range 5 {
+		release, err := l.acquire(context.Background())
+		require.NoError(t, err)
+		release()
+	}
+
+	// the first request is immediate, the following ones are spaced by 10ms
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
+
+func TestRequestLimiter_RateLimitContextDone(t *testing.T) {
+	l := newRequestLimiter(0.1, 1)
+
+	release, err := l.acquire(context.Background())
+	require.NoError(t, err)
+	release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = l.acquire(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// the slot is released on failure
+	assert.Empty(t, l.slots)
+}
+
+func TestLimiterTransport_ReleasedOnBodyClose(t *testing.T) {
+	server, _ := newStatusServer(t)
+	l := newRequestLimiter(0, 1)
+
+	client := &http.Client{Transport: &limiterTransport{Base: http.DefaultTransport, Limiter: l}}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	// the slot is held until the body is closed
+	assert.Len(t, l.slots, 1)
+	require.NoError(t, resp.Body.Close())
+	assert.Empty(t, l.slots)
+}
+
+func TestLimiterTransport_ReleasedOnError(t *testing.T) {
+	server, _ := newStatusServer(t)
+	l := newRequestLimiter(0, 1)
+
+	base := &failingTransport{Base: http.DefaultTransport, Err: context.Canceled, Failures: 1}
+	client := &http.Client{Transport: &limiterTransport{Base: base, Limiter: l}}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	_, err := client.Do(req)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, l.slots)
+}
+
+func TestLimiterTransport_ReleasedWhileWaitingRetry(t *testing.T) {
+	var requests atomic.Int32
+	firstRequest := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			close(firstRequest)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	l := newRequestLimiter(0, 1)
+	transport := newRetryTransport(&limiterTransport{Base: http.DefaultTransport, Limiter: l})
+	transport.MinWait = 200 * time.Millisecond
+	transport.MaxWait = 200 * time.Millisecond
+	client := &http.Client{Transport: transport}
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+		resp, err := client.Do(req)
+		if assert.NoError(t, err) {
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			resp.Body.Close()
+		}
+	})
+
+	// the slot is available while the request waits to be retried
+	<-firstRequest
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	release, err := l.acquire(ctx)
+	require.NoError(t, err)
+	release()
+
+	wg.Wait()
+	assert.Equal(t, int32(2), requests.Load())
+	assert.Empty(t, l.slots)
+}
+
+// blockingTokens is a tokenSource whose refresh blocks until released.
+type blockingTokens struct {
+	refreshing chan struct{}
+	proceed    chan struct{}
+}
+
+func (s *blockingTokens) Token(_ context.Context) (string, error) {
+	return "stale", nil
+}
+
+func (s *blockingTokens) Refresh(_ context.Context, _ string) (string, error) {
+	close(s.refreshing)
+	<-s.proceed
+	return "fresh", nil
+}
+
+func TestLimiterTransport_ReleasedWhileRefreshingToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	l := newRequestLimiter(0, 1)
+	tokens := &blockingTokens{refreshing: make(chan struct{}), proceed: make(chan struct{})}
+	client := &http.Client{
+		Transport: &authTransport{
+			Tokens: tokens,
+			Base:   &limiterTransport{Base: http.DefaultTransport, Limiter: l},
+		},
+	}
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+		resp, err := client.Do(req)
+		if assert.NoError(t, err) {
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			resp.Body.Close()
+		}
+	})
+
+	// the slot is available while the token is refreshed
+	<-tokens.refreshing
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	release, err := l.acquire(ctx)
+	require.NoError(t, err)
+	release()
+	close(tokens.proceed)
+
+	wg.Wait()
+	assert.Empty(t, l.slots)
+}
@@ -25,6 +25,29 @@ type TemplateInput struct {
 	Content     string  `json:"content"`
 }
 
+// TemplateListEntry is the data returned for each template when listing
+// templates.
+type TemplateListEntry struct {
+	ID          graphql.ID `graphql:"id"`
+	Name        string     `graphql:"name"`
+	Description *string    `graphql:"description"`
+	Transport   *string    `graphql:"transport"`
+}
+
+// TemplatesFilter defines filters for listing notification templates. Only
+// set fields are used for filtering.
+type TemplatesFilter struct {
+	Transport string
+}
+
+func (f TemplatesFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.Transport != "" {
+		filters = append(filters, newExactMatchFilter("transport", f.Transport))
+	}
+	return newAllOfFilter(filters)
+}
+
 type templateAPI struct {
 	c *client
 }
@@ -45,6 +68,24 @@ func (a templateAPI) Read(ctx context.Context, name string) (*Template, error) {
 	return &query.Template, nil
 }
 
+// List returns notification templates matching the filter.
+func (a templateAPI) List(ctx context.Context, filter TemplatesFilter) ([]TemplateListEntry, error) {
+	return paginate(ctx, func(cursor string) (*connection[TemplateListEntry], error) {
+		var query struct {
+			Templates connection[TemplateListEntry] `graphql:"templates(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      a.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.Templates, nil
+	})
+}
+
 // Upsert creates or updates a notification template.
 func (a templateAPI) Upsert(ctx context.Context, input TemplateInput) (*Template, error) {
 	var mutation struct {
@@ -0,0 +1,46 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+)
+
+// connection is a page of nodes returned by a paginated query.
+type connection[N any] struct {
+	Edges []struct {
+		Node N
+	}
+	PageInfo struct {
+		HasNextPage bool
+		EndCursor   string
+	}
+	Problems []problem
+}
+
+// paginate returns the nodes from all pages of a paginated query.
+//
+// The page function performs the query for the page starting at the provided
+// cursor, and returns its connection. Problems reported for a page are
+// returned as an error.
+func paginate[N any](ctx context.Context, page func(cursor string) (*connection[N], error)) ([]N, error) {
+	cursor := ""
+	nodes := make([]N, 0)
+	for {
+		conn, err := page(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if err := fromProblems(ctx, conn.Problems); err != nil {
+			return nil, err
+		}
+
+		for _, edge := range conn.Edges {
+			nodes = append(nodes, edge.Node)
+		}
+		if !conn.PageInfo.HasNextPage {
+			return nodes, nil
+		}
+		cursor = conn.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testConnection returns a connection with the provided nodes.
+func testConnection(nextCursor string, nodes ...string) *connection[string] {
+	conn := &connection[string]{}
+	for _, node := range nodes {
+		conn.Edges = append(conn.Edges, struct{ Node string }{Node: node})
+	}
+	conn.PageInfo.HasNextPage = nextCursor != ""
+	conn.PageInfo.EndCursor = nextCursor
+	return conn
+}
+
+func TestPaginate(t *testing.T) {
+	pages := map[string]*connection[string]{
+		"":       testConnection("first", "a", "b"),
+		"first":  testConnection("second", "c"),
+		"second": testConnection("", "d"),
+	}
+	cursors := make([]string, 0)
+
+	nodes, err := paginate(context.Background(), func(cursor string) (*connection[string], error) {
+		cursors = append(cursors, cursor)
+		return pages[cursor], nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, nodes)
+	assert.Equal(t, []string{"", "first", "second"}, cursors)
+}
+
+func TestPaginate_Empty(t *testing.T) {
+	nodes, err := paginate(context.Background(), func(cursor string) (*connection[string], error) {
+		return testConnection(""), nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, nodes)
+}
+
+func TestPaginate_Error(t *testing.T) {
+	nodes, err := paginate(context.Background(), func(cursor string) (*connection[string], error) {
+		if cursor == "" {
+			return testConnection("next", "a"), nil
+		}
+		return nil, errors.New("failed")
+	})
+
+	assert.EqualError(t, err, "failed")
+	assert.Nil(t, nodes)
+}
+
+func TestPaginate_Problems(t *testing.T) {
+	nodes, err := paginate(context.Background(), func(cursor string) (*connection[string], error) {
+		conn := testConnection("", "a")
+		conn.Problems = []problem{{Kind: "PermissionDenied", Message: "not allowed"}}
+		return conn, nil
+	})
+
+	assert.ErrorAs(t, err, new(PermissionDenied))
+	assert.Nil(t, nodes)
+}
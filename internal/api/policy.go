@@ -26,6 +26,57 @@ type Policy struct {
 	UnqualifiedName string     `graphql:"unqualifiedName"`
 }
 
+// PolicyListEntry is the data returned for each policy when listing policies.
+type PolicyListEntry struct {
+	ID              graphql.ID `graphql:"id"`
+	UUID            string     `graphql:"uuid"`
+	Name            string     `graphql:"name"`
+	Description     *string    `graphql:"description"`
+	Provider        string     `graphql:"provider"`
+	Version         int        `graphql:"version"`
+	Category        []string   `graphql:"category"`
+	Mode            string     `graphql:"mode"`
+	ResourceType    string     `graphql:"resourceType"`
+	Path            string     `graphql:"path"`
+	System          bool       `graphql:"system"`
+	UnqualifiedName string     `graphql:"unqualifiedName"`
+}
+
+// PoliciesFilter defines filters for listing policies. Only set fields are
+// used for filtering.
+type PoliciesFilter struct {
+	Provider CloudProvider
+	Category string
+	Mode     string
+	System   *bool
+	// UUIDs restricts results to policies with any of the UUIDs.
+	UUIDs []string
+}
+
+func (f PoliciesFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.Provider != "" {
+		filters = append(filters, newExactMatchFilter("provider", f.Provider))
+	}
+	if f.Category != "" {
+		filters = append(filters, newExactMatchFilter("category", f.Category))
+	}
+	if f.Mode != "" {
+		filters = append(filters, newExactMatchFilter("mode", f.Mode))
+	}
+	if f.System != nil {
+		filters = append(filters, newExactMatchFilter("system", *f.System))
+	}
+	if len(f.UUIDs) > 0 {
+		uuidFilters := make([]filterElementInput, len(f.UUIDs))
+		for i, uuid := range f.UUIDs {
+			uuidFilters[i] = newExactMatchFilter("uuid", uuid)
+		}
+		filters = append(filters, newCompositeFilter(uuidFilters, filterBooleanOR))
+	}
+	return newAllOfFilter(filters)
+}
+
 type policyAPI struct {
 	c *client
 }
@@ -50,3 +101,21 @@ func (a policyAPI) Read(ctx context.Context, uuid string, name string, version i
 
 	return &query.Policy, nil
 }
+
+// List returns the latest version of policies matching the filter.
+func (a policyAPI) List(ctx context.Context, filter PoliciesFilter) ([]PolicyListEntry, error) {
+	return paginate(ctx, func(cursor string) (*connection[PolicyListEntry], error) {
+		var query struct {
+			Policies connection[PolicyListEntry] `graphql:"policies(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      a.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.Policies, nil
+	})
+}
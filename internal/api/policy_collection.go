@@ -32,6 +32,32 @@ type RepositoryViewConfig struct {
 	PolicyFileSuffix  []string
 }
 
+// PolicyCollectionsFilter defines filters for listing policy collections.
+// Only set fields are used for filtering.
+type PolicyCollectionsFilter struct {
+	Provider       CloudProvider
+	System         *bool
+	Dynamic        *bool
+	RepositoryUUID string
+}
+
+func (f PolicyCollectionsFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.Provider != "" {
+		filters = append(filters, newExactMatchFilter("provider", f.Provider))
+	}
+	if f.System != nil {
+		filters = append(filters, newExactMatchFilter("system", *f.System))
+	}
+	if f.Dynamic != nil {
+		filters = append(filters, newExactMatchFilter("is-dynamic", *f.Dynamic))
+	}
+	if f.RepositoryUUID != "" {
+		filters = append(filters, newExactMatchFilter("repository-uuid", f.RepositoryUUID))
+	}
+	return newAllOfFilter(filters)
+}
+
 // PolicyCollectionCreateInput is the input to create a policy collection.
 type PolicyCollectionCreateInput struct {
 	Name           string               `json:"name"`
@@ -90,6 +116,24 @@ func (a policyCollectionAPI) Read(ctx context.Context, uuid string, name string)
 	return &query.PolicyCollection, nil
 }
 
+// List returns policy collections matching the filter.
+func (a policyCollectionAPI) List(ctx context.Context, filter PolicyCollectionsFilter) ([]PolicyCollection, error) {
+	return paginate(ctx, func(cursor string) (*connection[PolicyCollection], error) {
+		var query struct {
+			PolicyCollections connection[PolicyCollection] `graphql:"policyCollections(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      a.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.PolicyCollections, nil
+	})
+}
+
 // Create creates a policy collection.
 func (a policyCollectionAPI) Create(ctx context.Context, i PolicyCollectionCreateInput) (*PolicyCollection, error) {
 	var mutation struct {
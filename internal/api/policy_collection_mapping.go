@@ -121,3 +121,69 @@ func (a policyCollectionMappingAPI) Delete(ctx context.Context, id string) error
 	}
 	return nil
 }
+
+// List returns all the policy mappings for a policy collection.
+func (a policyCollectionMappingAPI) List(ctx context.Context, collectionUUID string) ([]PolicyCollectionMapping, error) {
+	return paginate(ctx, func(cursor string) (*connection[PolicyCollectionMapping], error) {
+		var query struct {
+			PolicyCollection struct {
+				UUID           string
+				PolicyMappings connection[PolicyCollectionMapping] `graphql:"policyMappings(first: $pageSize, after: $cursor)"`
+			} `graphql:"policyCollection(uuid: $uuid)"`
+		}
+		variables := map[string]any{
+			"uuid":     graphql.String(collectionUUID),
+			"pageSize": a.c.pageSize,
+			"cursor":   graphql.String(cursor),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		if query.PolicyCollection.UUID == "" {
+			return nil, NotFound{"Policy collection not found"}
+		}
+		return &query.PolicyCollection.PolicyMappings, nil
+	})
+}
+
+// UpsertMany creates or updates multiple policy collection mappings in a
+// single request.
+func (a policyCollectionMappingAPI) UpsertMany(ctx context.Context, inputs []PolicyCollectionMappingInput) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	var mutation struct {
+		Payload struct {
+			Mappings []struct {
+				ID graphql.ID
+			}
+		} `graphql:"upsertPolicyCollectionMappings(input: $input)"`
+	}
+	variables := map[string]any{
+		"input": upsertPolicyCollectionMappingsInput{Mappings: inputs},
+	}
+	return a.c.Mutate(ctx, &mutation, variables)
+}
+
+// DeleteMany removes multiple policy collection mappings in a single request.
+func (a policyCollectionMappingAPI) DeleteMany(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var mutation struct {
+		Payload struct {
+			Removed []struct {
+				ID graphql.ID
+			}
+		} `graphql:"removePolicyCollectionMappings(input: $input)"`
+	}
+	input := removePolicyCollectionMappingInput{
+		IDs: make([]graphql.ID, len(ids)),
+	}
+	for i, id := range ids {
+		input.IDs[i] = graphql.ID(id)
+	}
+	return a.c.Mutate(ctx, &mutation, map[string]any{"input": input})
+}
@@ -148,6 +148,41 @@ type SymphonyDeliverySettings struct {
 	Template       string      `graphql:"template" json:"template"`
 }
 
+// ReportGroupListEntry is the data returned for each report group when listing
+// report groups.
+type ReportGroupListEntry struct {
+	ID                 graphql.ID   `graphql:"id"`
+	Name               string       `graphql:"name"`
+	Enabled            bool         `graphql:"enabled"`
+	Bindings           []string     `graphql:"bindings"`
+	Source             ReportSource `graphql:"source"`
+	Schedule           string       `graphql:"schedule"`
+	GroupBy            []string     `graphql:"groupBy"`
+	UseMessageSettings bool         `graphql:"useMessageSettings"`
+}
+
+// ReportGroupsFilter defines filters for listing report groups. Only set
+// fields are used for filtering.
+type ReportGroupsFilter struct {
+	Source      ReportSource
+	Enabled     *bool
+	BindingUUID string
+}
+
+func (f ReportGroupsFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.Source != "" {
+		filters = append(filters, newExactMatchFilter("source", string(f.Source)))
+	}
+	if f.Enabled != nil {
+		filters = append(filters, newExactMatchFilter("enabled", *f.Enabled))
+	}
+	if f.BindingUUID != "" {
+		filters = append(filters, newExactMatchFilter("binding-uuid", f.BindingUUID))
+	}
+	return newAllOfFilter(filters)
+}
+
 // ReportGroupsInput is the input to create or update a report group.
 type ReportGroupInput struct {
 	Name               string                       `json:"name"`
@@ -202,6 +237,24 @@ func (a reportGroupAPI) Read(ctx context.Context, name string) (*ReportGroup, er
 	return &query.ReportGroup, nil
 }
 
+// List returns report groups matching the filter.
+func (a reportGroupAPI) List(ctx context.Context, filter ReportGroupsFilter) ([]ReportGroupListEntry, error) {
+	return paginate(ctx, func(cursor string) (*connection[ReportGroupListEntry], error) {
+		var query struct {
+			ReportGroups connection[ReportGroupListEntry] `graphql:"reportGroups(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      a.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.ReportGroups, nil
+	})
+}
+
 // Upsert creates or updates a report group.
 func (a reportGroupAPI) Upsert(ctx context.Context, input ReportGroupInput) (*ReportGroup, error) {
 	var mutation struct {
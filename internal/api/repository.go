@@ -5,6 +5,8 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"net/url"
+	"strings"
 
 	"github.com/hasura/go-graphql-client"
 )
@@ -102,6 +104,42 @@ func (i RepositoryDeleteInput) GetGraphQLType() string {
 	return "RemoveRepositoryConfigInput"
 }
 
+// RepositoriesFilter defines filters for listing repositories. Only set fields
+// are used for filtering.
+//
+// Repositories can't be filtered by the API, so filters are applied on the
+// client side.
+type RepositoriesFilter struct {
+	Host   string
+	System *bool
+}
+
+func (f RepositoriesFilter) matches(repo Repository) bool {
+	if f.Host != "" && !strings.EqualFold(repositoryURLHost(repo.URL), f.Host) {
+		return false
+	}
+	if f.System != nil && repo.System != *f.System {
+		return false
+	}
+	return true
+}
+
+// repositoryURLHost returns the host for a repository URL, supporting both
+// standard and SCP-like (user@host:path) URLs.
+func repositoryURLHost(repoURL string) string {
+	if u, err := url.Parse(repoURL); err == nil && u.Host != "" {
+		return u.Hostname()
+	}
+	host, _, found := strings.Cut(repoURL, ":")
+	if !found {
+		return ""
+	}
+	if i := strings.LastIndex(host, "@"); i >= 0 {
+		host = host[i+1:]
+	}
+	return host
+}
+
 type repositoryAPI struct {
 	c *client
 }
@@ -162,6 +200,34 @@ func (a repositoryAPI) FindByURL(ctx context.Context, url string) (string, error
 	}
 }
 
+// List returns repositories matching the filter.
+func (a repositoryAPI) List(ctx context.Context, filter RepositoriesFilter) ([]Repository, error) {
+	nodes, err := paginate(ctx, func(cursor string) (*connection[Repository], error) {
+		var query struct {
+			Conn connection[Repository] `graphql:"repositoryConfigs(first: $pageSize, after: $cursor)"`
+		}
+		variables := map[string]any{
+			"pageSize": a.c.pageSize,
+			"cursor":   graphql.String(cursor),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.Conn, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	repos := make([]Repository, 0)
+	for _, repo := range nodes {
+		if filter.matches(repo) {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, nil
+}
+
 func (a repositoryAPI) Create(ctx context.Context, i RepositoryCreateInput) (*Repository, error) {
 	var m struct {
 		Payload struct {
@@ -0,0 +1,30 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositoryURLHost(t *testing.T) {
+	assert.Equal(t, "github.com", repositoryURLHost("https://github.com/org/repo"))
+	assert.Equal(t, "gitlab.example.com", repositoryURLHost("https://user@gitlab.example.com:8443/org/repo.git"))
+	assert.Equal(t, "github.com", repositoryURLHost("ssh://git@github.com/org/repo.git"))
+	assert.Equal(t, "github.com", repositoryURLHost("git@github.com:org/repo.git"))
+	assert.Equal(t, "", repositoryURLHost("not-a-url"))
+}
+
+func TestRepositoriesFilter(t *testing.T) {
+	repo := Repository{URL: "git@github.com:org/repo.git", System: false}
+
+	assert.True(t, RepositoriesFilter{}.matches(repo))
+	assert.True(t, RepositoriesFilter{Host: "GitHub.com"}.matches(repo))
+	assert.False(t, RepositoriesFilter{Host: "gitlab.com"}.matches(repo))
+
+	system := true
+	assert.False(t, RepositoriesFilter{System: &system}.matches(repo))
+	system = false
+	assert.True(t, RepositoriesFilter{Host: "github.com", System: &system}.matches(repo))
+}
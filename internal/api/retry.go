@@ -0,0 +1,184 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// retryableStatusCodes are HTTP response codes for which queries are retried.
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+type mutationContextKey struct{}
+
+// withMutation marks the context as used for a GraphQL mutation.
+func withMutation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mutationContextKey{}, true)
+}
+
+// isMutation returns whether the context is used for a GraphQL mutation.
+func isMutation(ctx context.Context) bool {
+	v, _ := ctx.Value(mutationContextKey{}).(bool)
+	return v
+}
+
+// retryTransport is an http.Transport that retries failed requests with
+// exponential backoff.
+//
+// Queries are retried both on connection errors and on throttling or gateway
+// errors from the API. Since mutations are not idempotent, they're only
+// retried when the connection can't be established, as the request has not
+// been sent yet.
+type retryTransport struct {
+	Ctx        context.Context
+	Base       http.RoundTripper
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Read the body upfront since it needs to be sent again on each attempt
+	hasBody := req.Body != nil && req.Body != http.NoBody
+	body, err := decodeRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := req.Context()
+	mutation := isMutation(ctx)
+	for attempt := 0; ; attempt++ {
+		r := req.Clone(ctx)
+		if hasBody {
+			r.Body = io.NopCloser(bytes.NewReader([]byte(body)))
+		}
+
+		resp, err := t.Base.RoundTrip(r)
+		if attempt >= t.MaxRetries || !shouldRetry(resp, err, mutation) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		info := map[string]any{
+			"attempt":  attempt + 1,
+			"wait":     wait.String(),
+			"mutation": mutation,
+		}
+		if err != nil {
+			info["error"] = err.Error()
+		} else {
+			info["resp_status"] = resp.Status
+			// drain the body so that the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		tflog.Warn(t.Ctx, "Retrying GraphQL request", info)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt.
+//
+// If the response includes a Retry-After header, it's honored up to MaxWait,
+// otherwise the wait time grows exponentially from MinWait up to MaxWait.
+// Exponential waits use equal jitter, randomizing the second half of the
+// interval, so that concurrent requests throttled together don't all retry at
+// the same time.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.MaxWait)
+		}
+	}
+
+	wait := t.MaxWait
+	if exp := float64(t.MinWait) * math.Pow(2, float64(attempt)); exp < float64(t.MaxWait) {
+		wait = time.Duration(exp)
+	}
+	half := wait / 2
+	return half + rand.N(wait-half+1)
+}
+
+// shouldRetry returns whether a request should be retried based on its
+// outcome.
+func shouldRetry(resp *http.Response, err error, mutation bool) bool {
+	if err != nil {
+		if mutation {
+			return isDialError(err)
+		}
+		return isConnectionError(err)
+	}
+	if mutation {
+		return false
+	}
+	return slices.Contains(retryableStatusCodes, resp.StatusCode)
+}
+
+// isConnectionError returns whether the error is caused by a failure at the
+// connection level.
+func isConnectionError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// isDialError returns whether the error happened while establishing the
+// connection, before any part of the request was sent.
+func isDialError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which can be
+// either a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
@@ -0,0 +1,253 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingTransport fails the first Failures requests with Err.
+type failingTransport struct {
+	Base     http.RoundTripper
+	Err      error
+	Failures int
+	calls    int
+}
+
+func (t *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	if t.calls <= t.Failures {
+		return nil, t.Err
+	}
+	return t.Base.RoundTrip(req)
+}
+
+func newRetryTransport(base http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		Ctx:        context.Background(),
+		Base:       base,
+		MaxRetries: 3,
+		MinWait:    time.Millisecond,
+		MaxWait:    5 * time.Millisecond,
+	}
+}
+
+func newStatusServer(t *testing.T, statuses ...int) (*httptest.Server, *[]string) {
+	bodies := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		status := http.StatusOK
+		if len(bodies) <= len(statuses) {
+			status = statuses[len(bodies)-1]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &bodies
+}
+
+func TestRetryTransport_QueryRetriedOnStatus(t *testing.T) {
+	server, bodies := newStatusServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusBadGateway)
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport)}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{"query":"query {}"}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	// the body is sent again on each attempt
+	assert.Equal(t, []string{
+		`{"query":"query {}"}`,
+		`{"query":"query {}"}`,
+		`{"query":"query {}"}`,
+		`{"query":"query {}"}`,
+	}, *bodies)
+}
+
+func TestRetryTransport_QueryRetriesExhausted(t *testing.T) {
+	server, bodies := newStatusServer(t,
+		http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout, http.StatusGatewayTimeout,
+	)
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport)}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+	assert.Len(t, *bodies, 4)
+}
+
+func TestRetryTransport_NotRetriedOnOtherStatus(t *testing.T) {
+	server, bodies := newStatusServer(t, http.StatusInternalServerError)
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport)}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestRetryTransport_MutationNotRetriedOnStatus(t *testing.T) {
+	server, bodies := newStatusServer(t, http.StatusServiceUnavailable)
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport)}
+	req, _ := http.NewRequestWithContext(withMutation(context.Background()), "POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestRetryTransport_MutationRetriedOnDialError(t *testing.T) {
+	server, bodies := newStatusServer(t)
+
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	base := &failingTransport{Base: http.DefaultTransport, Err: dialErr, Failures: 2}
+	client := &http.Client{Transport: newRetryTransport(base)}
+	req, _ := http.NewRequestWithContext(withMutation(context.Background()), "POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, base.calls)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestRetryTransport_MutationNotRetriedAfterSend(t *testing.T) {
+	for name, sendErr := range map[string]error{
+		"reset":   syscall.ECONNRESET,
+		"eof":     io.ErrUnexpectedEOF,
+		"timeout": &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server, _ := newStatusServer(t)
+
+			base := &failingTransport{Base: http.DefaultTransport, Err: sendErr, Failures: 1}
+			client := &http.Client{Transport: newRetryTransport(base)}
+			req, _ := http.NewRequestWithContext(withMutation(context.Background()), "POST", server.URL, strings.NewReader(`{}`))
+			_, err := client.Do(req)
+
+			assert.ErrorIs(t, err, sendErr)
+			assert.Equal(t, 1, base.calls)
+		})
+	}
+}
+
+func TestRetryTransport_QueryRetriedOnConnectionError(t *testing.T) {
+	server, bodies := newStatusServer(t)
+
+	base := &failingTransport{Base: http.DefaultTransport, Err: syscall.ECONNRESET, Failures: 2}
+	client := &http.Client{Transport: newRetryTransport(base)}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, base.calls)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestRetryTransport_NotRetriedOnOtherErrors(t *testing.T) {
+	server, _ := newStatusServer(t)
+
+	base := &failingTransport{Base: http.DefaultTransport, Err: errors.New("boom"), Failures: 1}
+	client := &http.Client{Transport: newRetryTransport(base)}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	_, err := client.Do(req)
+
+	assert.ErrorContains(t, err, "boom")
+	assert.Equal(t, 1, base.calls)
+}
+
+func TestRetryTransport_RetriesDisabled(t *testing.T) {
+	server, bodies := newStatusServer(t, http.StatusServiceUnavailable)
+
+	transport := newRetryTransport(http.DefaultTransport)
+	transport.MaxRetries = 0
+	client := &http.Client{Transport: transport}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestRetryTransport_ContextCanceled(t *testing.T) {
+	server, bodies := newStatusServer(t, http.StatusServiceUnavailable)
+
+	transport := newRetryTransport(http.DefaultTransport)
+	transport.MinWait = time.Hour
+	transport.MaxWait = time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	client := &http.Client{Transport: transport}
+	req, _ := http.NewRequestWithContext(ctx, "POST", server.URL, strings.NewReader(`{}`))
+	_, err := client.Do(req)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, *bodies, 1)
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := &retryTransport{MinWait: time.Second, MaxWait: 10 * time.Second}
+
+	// waits are jittered between half and the full exponential interval
+	for attempt, interval := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second} {
+		for range 100 {
+			wait := transport.backoff(attempt, nil)
+			assert.GreaterOrEqual(t, wait, interval/2)
+			assert.LessOrEqual(t, wait, interval)
+		}
+	}
+	// concurrent retries don't all wait the same time
+	waits := make(map[time.Duration]bool)
+	for range 10 {
+		waits[transport.backoff(4, nil)] = true
+	}
+	assert.Greater(t, len(waits), 1)
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"5"}}}
+	assert.Equal(t, 5*time.Second, transport.backoff(0, resp))
+	// Retry-After is capped to the max wait
+	resp = &http.Response{Header: http.Header{"Retry-After": []string{"42"}}}
+	assert.Equal(t, 10*time.Second, transport.backoff(0, resp))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("5")
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Greater(t, wait, 59*time.Minute)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
@@ -16,6 +16,20 @@ type Role struct {
 	System      bool       `graphql:"system"`
 }
 
+// RolesFilter defines filters for listing roles. Only set fields are used for
+// filtering.
+type RolesFilter struct {
+	Permission string
+}
+
+func (f RolesFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.Permission != "" {
+		filters = append(filters, newExactMatchFilter("permission", f.Permission))
+	}
+	return newAllOfFilter(filters)
+}
+
 type roleAPI struct {
 	c *client
 }
@@ -42,3 +56,21 @@ func (r roleAPI) Read(ctx context.Context, name string) (*Role, error) {
 
 	return &query.Roles.Edges[0].Node, nil
 }
+
+// List returns roles matching the filter.
+func (r roleAPI) List(ctx context.Context, filter RolesFilter) ([]Role, error) {
+	return paginate(ctx, func(cursor string) (*connection[Role], error) {
+		var query struct {
+			Roles connection[Role] `graphql:"roles(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      r.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := r.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.Roles, nil
+	})
+}
@@ -202,11 +202,74 @@ func (a roleAssignmentAPI) Delete(ctx context.Context, roleName string, principa
 	return nil
 }
 
+// RoleGrant is a role granted to a principal on a target.
+type RoleGrant struct {
+	RoleName  string
+	Principal string
+	Target    string
+}
+
+// Update grants and revokes roles in a single request.
+func (a roleAssignmentAPI) Update(ctx context.Context, grant []RoleGrant, revoke []RoleGrant) error {
+	if len(grant) == 0 && len(revoke) == 0 {
+		return nil
+	}
+
+	var mutation struct {
+		UpdateRoleAssignment struct {
+			Grant  []grantRoleAssignmentPayload
+			Revoke []revokeRoleAssignmentPayload
+		} `graphql:"updateRoleAssignment(input: $input)"`
+	}
+
+	input := roleAssignmentInput{
+		Grant:  roleAssignmentItems(grant),
+		Revoke: roleAssignmentItems(revoke),
+	}
+
+	if err := a.c.Mutate(ctx, &mutation, map[string]any{"input": input}); err != nil {
+		return err
+	}
+
+	errs := make([]apiError, 0)
+	for _, payload := range mutation.UpdateRoleAssignment.Grant {
+		if payload.Error() != "" {
+			errs = append(errs, newAPIError(fmt.Errorf("failed to grant role assignment: %w", payload)))
+		}
+	}
+	for _, payload := range mutation.UpdateRoleAssignment.Revoke {
+		if payload.Error() != "" {
+			errs = append(errs, newAPIError(fmt.Errorf("failed to revoke role assignment: %w", payload)))
+		}
+	}
+	if len(errs) > 0 {
+		return newAPIErrors(errs)
+	}
+	return nil
+}
+
+func roleAssignmentItems(grants []RoleGrant) []roleAssignmentItemInput {
+	items := make([]roleAssignmentItemInput, len(grants))
+	for i, grant := range grants {
+		items[i] = roleAssignmentItemInput{
+			RoleName:  grant.RoleName,
+			Principal: grant.Principal,
+			Target:    grant.Target,
+		}
+	}
+	return items
+}
+
 // List returns role assignments for a target.
 func (a roleAssignmentAPI) List(ctx context.Context, target string) ([]RoleAssignment, error) {
 	return a.list(ctx, newExactMatchFilter("target", target))
 }
 
+// ListByPrincipal returns role assignments for a principal.
+func (a roleAssignmentAPI) ListByPrincipal(ctx context.Context, principal string) ([]RoleAssignment, error) {
+	return a.list(ctx, newExactMatchFilter("principal", principal))
+}
+
 func (a roleAssignmentAPI) list(ctx context.Context, filter filterElementInput) ([]RoleAssignment, error) {
 	cursor := ""
 	assignments := make([]RoleAssignment, 0)
@@ -0,0 +1,142 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryLeeway is how long before its expiration a token is refreshed.
+const tokenExpiryLeeway = 30 * time.Second
+
+// tokenSource provides the bearer token for API requests.
+type tokenSource interface {
+	// Token returns the current token.
+	Token(ctx context.Context) (string, error)
+	// Refresh returns a new token after the stale one was rejected by the
+	// API.
+	Refresh(ctx context.Context, stale string) (string, error)
+}
+
+// oauthTokenSource retrieves tokens from an OAuth2 token endpoint.
+//
+// If a refresh token is set, it's used to get new tokens via the
+// refresh_token grant, otherwise the client_credentials grant is used.
+type oauthTokenSource struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+	HTTPClient   *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// newOAuthTokenSource returns an oauthTokenSource, optionally starting from an
+// existing token.
+func newOAuthTokenSource(config ClientConfig, token string, httpClient *http.Client) *oauthTokenSource {
+	return &oauthTokenSource{
+		TokenURL:     config.TokenURL,
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		RefreshToken: config.RefreshToken,
+		HTTPClient:   httpClient,
+		token:        token,
+	}
+}
+
+// Token returns the current token, fetching a new one if it's not set or
+// about to expire.
+func (s *oauthTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == "" || (!s.expiry.IsZero() && time.Now().Add(tokenExpiryLeeway).After(s.expiry)) {
+		return s.fetch(ctx)
+	}
+	return s.token, nil
+}
+
+// Refresh fetches a new token, unless the current one differs from the stale
+// one, meaning it's already been refreshed by a concurrent request.
+func (s *oauthTokenSource) Refresh(ctx context.Context, stale string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != stale {
+		return s.token, nil
+	}
+	return s.fetch(ctx)
+}
+
+func (s *oauthTokenSource) fetch(ctx context.Context) (string, error) {
+	form := url.Values{}
+	if s.RefreshToken != "" {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", s.RefreshToken)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+	if s.ClientSecret == "" {
+		form.Set("client_id", s.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if s.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(s.ClientID), url.QueryEscape(s.ClientSecret))
+	}
+
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+
+	var payload struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		RefreshToken     string `json:"refresh_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	_ = json.Unmarshal(content, &payload)
+	if resp.StatusCode != http.StatusOK {
+		if payload.Error != "" {
+			return "", fmt.Errorf("token request failed: %s: %s", payload.Error, payload.ErrorDescription)
+		}
+		return "", fmt.Errorf("token request failed: %s", resp.Status)
+	}
+	if payload.AccessToken == "" {
+		return "", fmt.Errorf("token request failed: no access token returned")
+	}
+
+	s.token = payload.AccessToken
+	s.expiry = time.Time{}
+	if payload.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second)
+	}
+	// the refresh token might be rotated
+	if payload.RefreshToken != "" && s.RefreshToken != "" {
+		s.RefreshToken = payload.RefreshToken
+	}
+	return s.token, nil
+}
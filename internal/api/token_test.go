@@ -0,0 +1,149 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTokenServer returns a server issuing tokens ("token-1", "token-2", ...)
+// and the list of forms received.
+func newTokenServer(t *testing.T, expiresIn int) (*httptest.Server, *[]url.Values) {
+	forms := make([]url.Values, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if user, pass, ok := r.BasicAuth(); ok {
+			r.PostForm.Set("basic_auth", user+":"+pass)
+		}
+		forms = append(forms, r.PostForm)
+		if r.PostForm.Get("refresh_token") == "revoked" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_grant", "error_description": "Refresh Token has been revoked"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "token-" + strconv.Itoa(len(forms)),
+			"expires_in":   expiresIn,
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &forms
+}
+
+func TestOAuthTokenSource_ClientCredentials(t *testing.T) {
+	server, forms := newTokenServer(t, 3600)
+	source := &oauthTokenSource{
+		TokenURL:     server.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		HTTPClient:   server.Client(),
+	}
+
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	// the token is cached until it expires
+	token, err = source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	require.Len(t, *forms, 1)
+	assert.Equal(t, "client_credentials", (*forms)[0].Get("grant_type"))
+	assert.Equal(t, "client:secret", (*forms)[0].Get("basic_auth"))
+}
+
+func TestOAuthTokenSource_RefreshToken(t *testing.T) {
+	server, forms := newTokenServer(t, 3600)
+	source := &oauthTokenSource{
+		TokenURL:     server.URL,
+		ClientID:     "client",
+		RefreshToken: "refresh",
+		HTTPClient:   server.Client(),
+		token:        "initial",
+	}
+
+	// the initial token is used until it's rejected
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "initial", token)
+
+	token, err = source.Refresh(context.Background(), "initial")
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	// the token is not refreshed again if it's already changed
+	token, err = source.Refresh(context.Background(), "initial")
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	require.Len(t, *forms, 1)
+	assert.Equal(t, "refresh_token", (*forms)[0].Get("grant_type"))
+	assert.Equal(t, "refresh", (*forms)[0].Get("refresh_token"))
+	assert.Equal(t, "client", (*forms)[0].Get("client_id"))
+}
+
+func TestOAuthTokenSource_Expired(t *testing.T) {
+	server, forms := newTokenServer(t, 1)
+	source := &oauthTokenSource{
+		TokenURL:     server.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		HTTPClient:   server.Client(),
+	}
+
+	_, _ = source.Token(context.Background())
+	// the token expires within the leeway, so a new one is fetched
+	token, err := source.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+	assert.Len(t, *forms, 2)
+}
+
+func TestOAuthTokenSource_Error(t *testing.T) {
+	server, _ := newTokenServer(t, 3600)
+	source := &oauthTokenSource{
+		TokenURL:     server.URL,
+		ClientID:     "client",
+		RefreshToken: "revoked",
+		HTTPClient:   server.Client(),
+	}
+
+	_, err := source.Token(context.Background())
+	assert.EqualError(t, err, "token request failed: invalid_grant: Refresh Token has been revoked")
+}
+
+func TestAuthTransport_TokenRefreshedOnUnauthorized(t *testing.T) {
+	tokenServer, _ := newTokenServer(t, 3600)
+	var auths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auths = append(auths, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	tokens := newOAuthTokenSource(
+		ClientConfig{TokenURL: tokenServer.URL, ClientID: "client", RefreshToken: "refresh"},
+		"expired",
+		tokenServer.Client(),
+	)
+	client := &http.Client{Transport: &authTransport{Tokens: tokens, Base: http.DefaultTransport}}
+	req, _ := http.NewRequest("POST", server.URL, strings.NewReader(`{}`))
+	resp, err := client.Do(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"Bearer expired", "Bearer token-1"}, auths)
+}
@@ -0,0 +1,116 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+)
+
+// proxySchemes are the URL schemes supported for the proxy.
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// transportError represents an error in the configuration of the HTTP
+// transport.
+type transportError struct {
+	Detail string
+}
+
+// Summary returns the error summary.
+func (e transportError) Summary() string {
+	return "Invalid HTTP Transport Configuration"
+}
+
+// Error returns the error message.
+func (e transportError) Error() string {
+	return e.Detail
+}
+
+// hasTransportConfig returns whether the configuration requires a dedicated
+// transport.
+func hasTransportConfig(config ClientConfig) bool {
+	return config.ProxyURL != "" ||
+		config.CACertFile != "" ||
+		config.CACertPEM != "" ||
+		config.ClientCert != "" ||
+		config.ClientKey != "" ||
+		config.InsecureSkipVerify
+}
+
+// newBaseTransport returns the http.RoundTripper performing requests to the
+// API.
+//
+// Unless proxy or TLS options are configured, http.DefaultTransport is used.
+func newBaseTransport(config ClientConfig) (http.RoundTripper, error) {
+	if !hasTransportConfig(config) {
+		return http.DefaultTransport, nil
+	}
+
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, transportError{"Proxy and TLS options are not supported with a custom default HTTP transport"}
+	}
+	transport := defaultTransport.Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, transportError{fmt.Sprintf("Invalid proxy URL: %s", err)}
+		}
+		if !slices.Contains(proxySchemes, proxyURL.Scheme) || proxyURL.Host == "" {
+			return nil, transportError{fmt.Sprintf("Invalid proxy URL %q, must be an http, https or socks5 URL", config.ProxyURL)}
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// newTLSConfig returns the TLS configuration for the API connection.
+func newTLSConfig(config ClientConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACertFile != "" || config.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		caCert := []byte(config.CACertPEM)
+		if config.CACertFile != "" {
+			caCert, err = os.ReadFile(config.CACertFile)
+			if err != nil {
+				return nil, transportError{fmt.Sprintf("Failed to read CA certificate file: %s", err)}
+			}
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, transportError{"No valid PEM certificate found in the CA certificate"}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, transportError{"Both the client certificate and key must be provided"}
+		}
+		cert, err := tls.X509KeyPair([]byte(config.ClientCert), []byte(config.ClientKey))
+		if err != nil {
+			return nil, transportError{fmt.Sprintf("Invalid client certificate: %s", err)}
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
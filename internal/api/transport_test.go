@@ -0,0 +1,157 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTLSServer returns a TLS test server and its certificate as PEM.
+func newTLSServer(t *testing.T) (*httptest.Server, string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) > 0 {
+			w.Header().Set("X-Client-CN", r.TLS.PeerCertificates[0].Subject.CommonName)
+		}
+	}))
+	t.Cleanup(server.Close)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, string(certPEM)
+}
+
+// newClientCert returns a self-signed client certificate and key as PEM.
+func newClientCert(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func doGet(t *testing.T, transport http.RoundTripper, url string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	require.NoError(t, err)
+	return (&http.Client{Transport: transport}).Do(req)
+}
+
+func TestNewBaseTransport_Default(t *testing.T) {
+	transport, err := newBaseTransport(ClientConfig{})
+	require.NoError(t, err)
+	assert.Same(t, http.DefaultTransport, transport)
+}
+
+func TestNewBaseTransport_UntrustedServer(t *testing.T) {
+	server, _ := newTLSServer(t)
+	otherCA, _ := newClientCert(t)
+
+	transport, err := newBaseTransport(ClientConfig{CACertPEM: otherCA})
+	require.NoError(t, err)
+	_, err = doGet(t, transport, server.URL)
+	assert.ErrorContains(t, err, "certificate")
+}
+
+func TestNewBaseTransport_CACertPEM(t *testing.T) {
+	server, certPEM := newTLSServer(t)
+
+	transport, err := newBaseTransport(ClientConfig{CACertPEM: certPEM})
+	require.NoError(t, err)
+	resp, err := doGet(t, transport, server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewBaseTransport_CACertFile(t *testing.T) {
+	server, certPEM := newTLSServer(t)
+	certFile := path.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(certFile, []byte(certPEM), 0o644))
+
+	transport, err := newBaseTransport(ClientConfig{CACertFile: certFile})
+	require.NoError(t, err)
+	resp, err := doGet(t, transport, server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewBaseTransport_InvalidCACert(t *testing.T) {
+	_, err := newBaseTransport(ClientConfig{CACertPEM: "not a certificate"})
+	assert.EqualError(t, err, "No valid PEM certificate found in the CA certificate")
+
+	_, err = newBaseTransport(ClientConfig{CACertFile: "/does/not/exist.pem"})
+	assert.ErrorContains(t, err, "Failed to read CA certificate file")
+}
+
+func TestNewBaseTransport_InsecureSkipVerify(t *testing.T) {
+	server, _ := newTLSServer(t)
+
+	transport, err := newBaseTransport(ClientConfig{InsecureSkipVerify: true})
+	require.NoError(t, err)
+	resp, err := doGet(t, transport, server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewBaseTransport_ClientCert(t *testing.T) {
+	server, certPEM := newTLSServer(t)
+	server.TLS.ClientAuth = tls.RequireAnyClientCert
+	clientCert, clientKey := newClientCert(t)
+
+	transport, err := newBaseTransport(ClientConfig{
+		CACertPEM:  certPEM,
+		ClientCert: clientCert,
+		ClientKey:  clientKey,
+	})
+	require.NoError(t, err)
+	resp, err := doGet(t, transport, server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "test-client", resp.Header.Get("X-Client-CN"))
+}
+
+func TestNewBaseTransport_InvalidClientCert(t *testing.T) {
+	clientCert, _ := newClientCert(t)
+
+	_, err := newBaseTransport(ClientConfig{ClientCert: clientCert})
+	assert.EqualError(t, err, "Both the client certificate and key must be provided")
+
+	_, err = newBaseTransport(ClientConfig{ClientCert: clientCert, ClientKey: "invalid"})
+	assert.ErrorContains(t, err, "Invalid client certificate")
+}
+
+func TestNewBaseTransport_ProxyURL(t *testing.T) {
+	transport, err := newBaseTransport(ClientConfig{ProxyURL: "http://proxy.example.com:3128"})
+	require.NoError(t, err)
+	httpTransport, ok := transport.(*http.Transport)
+	require.True(t, ok)
+	proxy, err := httpTransport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "api.example.com"}})
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy.example.com:3128", proxy.String())
+
+	_, err = newBaseTransport(ClientConfig{ProxyURL: "ftp://proxy.example.com"})
+	assert.EqualError(t, err, `Invalid proxy URL "ftp://proxy.example.com", must be an http, https or socks5 URL`)
+}
@@ -21,6 +21,26 @@ type User struct {
 	Username                *string    `graphql:"username"`
 }
 
+// UsersFilter defines filters for listing users. Only set fields are used for
+// filtering.
+type UsersFilter struct {
+	Active *bool
+}
+
+// Like for lookups by username, user filters don't take an operator.
+func (f UsersFilter) filterElement() *optionalFilterElementInput {
+	filters := make([]filterElementInput, 0)
+	if f.Active != nil {
+		filters = append(filters, newSimpleFilter("active", *f.Active))
+	}
+	return newAllOfFilter(filters)
+}
+
+// Caller is the identity API requests are made as.
+type Caller struct {
+	User User `graphql:"user"`
+}
+
 // UserCreateInput is the input for creating a user.
 type UserCreateInput struct {
 	Name        string   `json:"name"`
@@ -76,6 +96,35 @@ func (u userAPI) Read(ctx context.Context, username string) (*User, error) {
 	return &query.Users.Edges[0].Node, nil
 }
 
+// Caller returns the identity API requests are made as.
+func (u userAPI) Caller(ctx context.Context) (*Caller, error) {
+	var query struct {
+		WhoAmI Caller `graphql:"whoAmI"`
+	}
+	if err := u.c.Query(ctx, &query, nil); err != nil {
+		return nil, err
+	}
+	return &query.WhoAmI, nil
+}
+
+// List returns users matching the filter.
+func (u userAPI) List(ctx context.Context, filter UsersFilter) ([]User, error) {
+	return paginate(ctx, func(cursor string) (*connection[User], error) {
+		var query struct {
+			Users connection[User] `graphql:"users(first: $pageSize, after: $cursor, filterElement: $filterElement)"`
+		}
+		variables := map[string]any{
+			"pageSize":      u.c.pageSize,
+			"cursor":        graphql.String(cursor),
+			"filterElement": filter.filterElement(),
+		}
+		if err := u.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.Users, nil
+	})
+}
+
 // Create creates a user.
 func (a userAPI) Create(ctx context.Context, i UserCreateInput) (*User, error) {
 	var mutation struct {
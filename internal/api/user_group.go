@@ -90,6 +90,23 @@ func (a userGroupAPI) ReadByName(ctx context.Context, name string) (*UserGroup,
 	return &query.UserGroups.Edges[0].Node, nil
 }
 
+// List returns all user groups.
+func (a userGroupAPI) List(ctx context.Context) ([]UserGroup, error) {
+	return paginate(ctx, func(cursor string) (*connection[UserGroup], error) {
+		var query struct {
+			UserGroups connection[UserGroup] `graphql:"userGroups(first: $pageSize, after: $cursor)"`
+		}
+		variables := map[string]any{
+			"pageSize": a.c.pageSize,
+			"cursor":   graphql.String(cursor),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		return &query.UserGroups, nil
+	})
+}
+
 // Create creates a user group.
 func (a userGroupAPI) Create(ctx context.Context, i UserGroupCreateInput) (*UserGroup, error) {
 	var mutation struct {
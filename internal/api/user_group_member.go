@@ -0,0 +1,63 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package api
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+)
+
+// UserGroupMember is a user in a user group.
+type UserGroupMember struct {
+	GroupUUID string
+	UserKey   int64
+	Username  *string
+}
+
+type userGroupMemberAPI struct {
+	c *client
+}
+
+// userGroupUserNode is a user listed as member of a user group.
+type userGroupUserNode struct {
+	Key      int64
+	Username *string
+}
+
+// List returns all the members of a user group.
+func (a userGroupMemberAPI) List(ctx context.Context, groupUUID string) ([]UserGroupMember, error) {
+	nodes, err := paginate(ctx, func(cursor string) (*connection[userGroupUserNode], error) {
+		var query struct {
+			UserGroup struct {
+				UUID  string
+				Users connection[userGroupUserNode] `graphql:"users(first: $pageSize, after: $cursor)"`
+			} `graphql:"userGroup(uuid: $uuid)"`
+		}
+		variables := map[string]any{
+			"uuid":     UUID(groupUUID),
+			"pageSize": a.c.pageSize,
+			"cursor":   graphql.String(cursor),
+		}
+		if err := a.c.Query(ctx, &query, variables); err != nil {
+			return nil, err
+		}
+		if query.UserGroup.UUID == "" {
+			return nil, NotFound{"User group not found"}
+		}
+		return &query.UserGroup.Users, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]UserGroupMember, len(nodes))
+	for i, node := range nodes {
+		members[i] = UserGroupMember{
+			GroupUUID: groupUUID,
+			UserKey:   node.Key,
+			Username:  node.Username,
+		}
+	}
+	return members, nil
+}
@@ -0,0 +1,141 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var _ datasource.DataSource = &accountDiscoveryDataSource{}
+
+type accountDiscoveryDataSource struct {
+	apiDataSource
+}
+
+func (d *accountDiscoveryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_discovery"
+}
+
+func (d *accountDiscoveryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve an account discovery configuration by name. Secrets are never returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The GraphQL Node ID of the account discovery configuration.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The unique name of the account discovery configuration.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Human-readable notes about the account discovery configuration.",
+				Computed:    true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				Description: "The cloud provider for the account discovery configuration.",
+				Computed:    true,
+			},
+			"suspended": schema.BoolAttribute{
+				Description: "Whether the discovery schedule is suspended.",
+				Computed:    true,
+			},
+			"aws": schema.SingleNestedAttribute{
+				Description: "The configuration for AWS account discovery, only set for AWS.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"org_id": schema.StringAttribute{
+						Description: "The AWS organization ID.",
+						Computed:    true,
+					},
+					"org_read_role": schema.StringAttribute{
+						Description: "The ARN of an IAM role which has permission to read organization data.",
+						Computed:    true,
+					},
+					"member_role": schema.StringAttribute{
+						Description: "IAM role ARN template for AssetDB.",
+						Computed:    true,
+					},
+					"custodian_role": schema.StringAttribute{
+						Description: "IAM role name or template for Cloud Custodian.",
+						Computed:    true,
+					},
+				},
+			},
+			"azure": schema.SingleNestedAttribute{
+				Description: "The configuration for Azure account discovery, only set for Azure.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"tenant_id": schema.StringAttribute{
+						Description: "The Azure tenant ID.",
+						Computed:    true,
+					},
+					"client_id": schema.StringAttribute{
+						Description: "The Azure client ID.",
+						Computed:    true,
+					},
+				},
+			},
+			"gcp": schema.SingleNestedAttribute{
+				Description: "The configuration for GCP account discovery, only set for GCP.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"org_id": schema.StringAttribute{
+						Description: "The GCP organization ID.",
+						Computed:    true,
+					},
+					"root_folder_ids": schema.ListAttribute{
+						Description: "List of GCP folder IDs to scan.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"exclude_folder_ids": schema.ListAttribute{
+						Description: "List of GCP folder IDs to exclude from scanning.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"client_email": schema.StringAttribute{
+						Description: "The client email for the configuration.",
+						Computed:    true,
+					},
+					"client_id": schema.StringAttribute{
+						Description: "The client ID for the configuration.",
+						Computed:    true,
+					},
+					"project_id": schema.StringAttribute{
+						Description: "The project ID for the configuration.",
+						Computed:    true,
+					},
+					"private_key_id": schema.StringAttribute{
+						Description: "The private key ID.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *accountDiscoveryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.AccountDiscoveryDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountDiscovery, err := d.api.AccountDiscovery.Read(ctx, data.Name.ValueString())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(ctx, accountDiscovery)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,99 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+	"github.com/stacklet/terraform-provider-stacklet/internal/schemavalidate"
+)
+
+var _ datasource.DataSource = &accountGroupsDataSource{}
+
+type accountGroupsDataSource struct {
+	apiDataSource
+}
+
+func (d *accountGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_groups"
+}
+
+func (d *accountGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve account groups, optionally filtered by cloud provider.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: "Only return account groups for the cloud provider (AWS, Azure, GCP, Kubernetes, or TencentCloud).",
+				Optional:    true,
+				Validators: []validator.String{
+					schemavalidate.OneOfCloudProviders(),
+				},
+			},
+			"account_groups": schema.ListNestedAttribute{
+				Description: "The list of matching account groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the account group.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the account group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the account group.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the account group.",
+							Computed:    true,
+						},
+						"dynamic_filter": schema.StringAttribute{
+							Description: "Dynamic filter for accounts matching. Null means not dynamic, empty string matches all accounts.",
+							Computed:    true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							Description: "The cloud provider for the account group.",
+							Computed:    true,
+						},
+						"regions": schema.ListAttribute{
+							Description: "The regions for the account group.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"role_assignment_target": schema.StringAttribute{
+							Description: "An opaque identifier for role assignments. Use this value when assigning roles to the account group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *accountGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.AccountGroupsDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountGroups, err := d.api.AccountGroup.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(accountGroups)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,120 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+	"github.com/stacklet/terraform-provider-stacklet/internal/schemavalidate"
+)
+
+var _ datasource.DataSource = &accountsDataSource{}
+
+type accountsDataSource struct {
+	apiDataSource
+}
+
+func (d *accountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accounts"
+}
+
+func (d *accountsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve cloud accounts in Stacklet, optionally filtered. All filters are combined, and only accounts matching all of them are returned.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: "Only return accounts for the cloud provider (AWS, Azure, GCP, Kubernetes, or TencentCloud).",
+				Optional:    true,
+				Validators: []validator.String{
+					schemavalidate.OneOfCloudProviders(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Only return accounts with the email contact address.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Only return accounts with the specified active status.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only return accounts with all the specified tags.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"accounts": schema.ListNestedAttribute{
+				Description: "The list of matching accounts.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the account.",
+							Computed:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "The cloud specific identifier for the account (e.g., AWS account ID, GCP project ID, Azure subscription UUID).",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The display name for the account.",
+							Computed:    true,
+						},
+						"short_name": schema.StringAttribute{
+							Description: "The short name for the account.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "More detailed information about the account.",
+							Computed:    true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							Description: "The cloud provider for the account.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "The path used to group accounts in a hierarchy.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email contact address for the account.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the account is active.",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "The tags for the account.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *accountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.AccountsDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accounts, err := d.api.Account.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(accounts)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,116 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var _ datasource.DataSource = &bindingsDataSource{}
+
+type bindingsDataSource struct {
+	apiDataSource
+}
+
+func (d *bindingsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bindings"
+}
+
+func (d *bindingsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve bindings, optionally filtered. All filters are combined, and only bindings matching all of them are returned. System bindings are included unless filtered out.",
+		Attributes: map[string]schema.Attribute{
+			"account_group_uuid": schema.StringAttribute{
+				Description: "Only return bindings for the account group with the UUID.",
+				Optional:    true,
+			},
+			"policy_collection_uuid": schema.StringAttribute{
+				Description: "Only return bindings for the policy collection with the UUID.",
+				Optional:    true,
+			},
+			"auto_deploy": schema.BoolAttribute{
+				Description: "Only return bindings with the specified auto deploy setting.",
+				Optional:    true,
+			},
+			"dry_run": schema.BoolAttribute{
+				Description: "Only return bindings with the specified dry run setting.",
+				Optional:    true,
+			},
+			"system": schema.BoolAttribute{
+				Description: "Only return system (or non-system) bindings.",
+				Optional:    true,
+			},
+			"bindings": schema.ListNestedAttribute{
+				Description: "The list of matching bindings.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the binding.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the binding.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the binding.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the binding.",
+							Computed:    true,
+						},
+						"auto_deploy": schema.BoolAttribute{
+							Description: "Whether the binding automatically deploys when the policy collection changes.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "The schedule for the binding (e.g., 'rate(1 hour)', 'rate(2 hours)', or cron expression).",
+							Computed:    true,
+						},
+						"account_group_uuid": schema.StringAttribute{
+							Description: "The UUID of the account group this binding applies to.",
+							Computed:    true,
+						},
+						"policy_collection_uuid": schema.StringAttribute{
+							Description: "The UUID of the policy collection this binding applies.",
+							Computed:    true,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether this is a system binding.",
+							Computed:    true,
+						},
+						"dry_run": schema.BoolAttribute{
+							Description: "Whether the binding is run in with action disabled (in information mode).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *bindingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.BindingsDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bindings, err := d.api.Binding.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(bindings)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
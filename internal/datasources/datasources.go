@@ -26,8 +26,12 @@ func (d datasources) List(includeUnreleased bool) []func() datasource.DataSource
 var DataSources = datasources{
 	Released: []func() datasource.DataSource{
 		newFactory(&accountDataSource{}),
+		newFactory(&accountDiscoveryDataSource{}),
 		newFactory(&accountGroupDataSource{}),
+		newFactory(&accountGroupsDataSource{}),
+		newFactory(&accountsDataSource{}),
 		newFactory(&bindingDataSource{}),
+		newFactory(&bindingsDataSource{}),
 		newFactory(&configurationProfileAccountOwnersDataSource{}),
 		newFactory(&configurationProfileEmailDataSource{}),
 		newFactory(&configurationProfileJiraDataSource{}),
@@ -40,17 +44,25 @@ var DataSources = datasources{
 		newFactory(&gcpIntegrationSurfaceDataSource{}),
 		newFactory(&msteamsIntegrationSurfaceDataSource{}),
 		newFactory(&notificationTemplateDataSource{}),
+		newFactory(&notificationTemplatesDataSource{}),
 		newFactory(&platformDataSource{}),
+		newFactory(&policiesDataSource{}),
 		newFactory(&policyCollectionDataSource{}),
+		newFactory(&policyCollectionsDataSource{}),
 		newFactory(&policyDataSource{}),
 		newFactory(&reportGroupDataSource{}),
+		newFactory(&reportGroupsDataSource{}),
+		newFactory(&repositoriesDataSource{}),
 		newFactory(&repositoryDataSource{}),
 		newFactory(&roleAssignmentsDataSource{}),
 		newFactory(&roleDataSource{}),
+		newFactory(&rolesDataSource{}),
 		newFactory(&samlProviderDataSource{}),
 		newFactory(&ssoGroupDataSource{}),
 		newFactory(&userGroupDataSource{}),
+		newFactory(&userGroupsDataSource{}),
 		newFactory(&userDataSource{}),
+		newFactory(&usersDataSource{}),
 	},
 }
 
@@ -0,0 +1,76 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var _ datasource.DataSource = &notificationTemplatesDataSource{}
+
+type notificationTemplatesDataSource struct {
+	apiDataSource
+}
+
+func (d *notificationTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_templates"
+}
+
+func (d *notificationTemplatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve notification templates, optionally only those for a transport. Template content is not included, use the stacklet_notification_template data source to fetch it.",
+		Attributes: map[string]schema.Attribute{
+			"transport": schema.StringAttribute{
+				Description: "Only return templates for the notification transport.",
+				Optional:    true,
+			},
+			"templates": schema.ListNestedAttribute{
+				Description: "The list of matching templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the template.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the template.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the template.",
+							Computed:    true,
+						},
+						"transport": schema.StringAttribute{
+							Description: "The notification transport the template is for.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *notificationTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.NotificationTemplatesDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	templates, err := d.api.Template.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(templates)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
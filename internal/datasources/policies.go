@@ -0,0 +1,127 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+	"github.com/stacklet/terraform-provider-stacklet/internal/schemavalidate"
+)
+
+var _ datasource.DataSource = &policiesDataSource{}
+
+type policiesDataSource struct {
+	apiDataSource
+}
+
+func (d *policiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *policiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve the latest version of policies, optionally filtered. All filters are combined, and only policies matching all of them are returned.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: "Only return policies for the cloud provider (AWS, Azure, GCP, Kubernetes, or TencentCloud).",
+				Optional:    true,
+				Validators: []validator.String{
+					schemavalidate.OneOfCloudProviders(),
+				},
+			},
+			"category": schema.StringAttribute{
+				Description: "Only return policies in the category.",
+				Optional:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "Only return policies with the mode (e.g. pull).",
+				Optional:    true,
+			},
+			"system": schema.BoolAttribute{
+				Description: "Only return system (or non-system) policies.",
+				Optional:    true,
+			},
+			"policies": schema.ListNestedAttribute{
+				Description: "The list of matching policies.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the policy.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the policy.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the policy.",
+							Computed:    true,
+						},
+						"unqualified_name": schema.StringAttribute{
+							Description: "The policy name without namespace prefix.",
+							Computed:    true,
+						},
+						"version": schema.Int32Attribute{
+							Description: "The version of the policy.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the policy.",
+							Computed:    true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							Description: "The cloud provider for the policy.",
+							Computed:    true,
+						},
+						"category": schema.ListAttribute{
+							Description: "The list of categories the policy belongs to.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"mode": schema.StringAttribute{
+							Description: "The policy mode.",
+							Computed:    true,
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "The resource type that the policy applies to.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "The path of the policy in the source repository.",
+							Computed:    true,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether this is a system policy.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *policiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.PoliciesDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := d.api.Policy.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(policies)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,142 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+	"github.com/stacklet/terraform-provider-stacklet/internal/schemavalidate"
+)
+
+var _ datasource.DataSource = &policyCollectionsDataSource{}
+
+type policyCollectionsDataSource struct {
+	apiDataSource
+}
+
+func (d *policyCollectionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_collections"
+}
+
+func (d *policyCollectionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve policy collections, optionally filtered. All filters are combined, and only policy collections matching all of them are returned.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Description: "Only return policy collections for the cloud provider (AWS, Azure, GCP, Kubernetes, or TencentCloud).",
+				Optional:    true,
+				Validators: []validator.String{
+					schemavalidate.OneOfCloudProviders(),
+				},
+			},
+			"system": schema.BoolAttribute{
+				Description: "Only return system (or non-system) policy collections.",
+				Optional:    true,
+			},
+			"dynamic": schema.BoolAttribute{
+				Description: "Only return dynamic (or non-dynamic) policy collections.",
+				Optional:    true,
+			},
+			"repository_uuid": schema.StringAttribute{
+				Description: "Only return dynamic policy collections linked to the repository with the UUID.",
+				Optional:    true,
+			},
+			"policy_collections": schema.ListNestedAttribute{
+				Description: "The list of matching policy collections.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the policy collection.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the policy collection.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the policy collection.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the policy collection.",
+							Computed:    true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							Description: "The cloud provider for the policy collection.",
+							Computed:    true,
+						},
+						"auto_update": schema.BoolAttribute{
+							Description: "Whether the policy collection automatically updates policy versions.",
+							Computed:    true,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether this is a system policy collection.",
+							Computed:    true,
+						},
+						"dynamic": schema.BoolAttribute{
+							Description: "Whether this is a dynamic policy collection.",
+							Computed:    true,
+						},
+						"dynamic_config": schema.SingleNestedAttribute{
+							Description: "Configuration for dynamic behavior, including the repository the collection is linked to and its view.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"repository_uuid": schema.StringAttribute{
+									Description: "The UUID of the repository the collection is linked to.",
+									Computed:    true,
+								},
+								"namespace": schema.StringAttribute{
+									Description: "The namespace for policies from the repository.",
+									Computed:    true,
+								},
+								"branch_name": schema.StringAttribute{
+									Description: "The repository branch from which policies are imported.",
+									Computed:    true,
+								},
+								"policy_directories": schema.ListAttribute{
+									Description: "Optional list of subdirectory to limit the scan to.",
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"policy_file_suffixes": schema.ListAttribute{
+									Description: "Optional list of suffixes for policy files to limit the scan to.",
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+							},
+						},
+						"role_assignment_target": schema.StringAttribute{
+							Description: "An opaque identifier for role assignments. Use this value when assigning roles to the policy collection.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *policyCollectionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.PolicyCollectionsDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyCollections, err := d.api.PolicyCollection.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(ctx, policyCollections)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
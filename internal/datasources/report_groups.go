@@ -0,0 +1,113 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var _ datasource.DataSource = &reportGroupsDataSource{}
+
+type reportGroupsDataSource struct {
+	apiDataSource
+}
+
+func (d *reportGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_report_groups"
+}
+
+func (d *reportGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve notification report groups, optionally filtered. All filters are combined, and only report groups matching all of them are returned. Delivery settings are not included, use the stacklet_report_group data source to fetch them.",
+		Attributes: map[string]schema.Attribute{
+			"source": schema.StringAttribute{
+				Description: "Only return report groups with the specified source type.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(api.ReportSourceBinding),
+						string(api.ReportSourceControl),
+						string(api.ReportSourcePolicy),
+					),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Only return enabled (or disabled) report groups.",
+				Optional:    true,
+			},
+			"binding_uuid": schema.StringAttribute{
+				Description: "Only return report groups for the binding with the UUID.",
+				Optional:    true,
+			},
+			"report_groups": schema.ListNestedAttribute{
+				Description: "The list of matching report groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the report group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name for the report group.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the report group is enabled.",
+							Computed:    true,
+						},
+						"bindings": schema.ListAttribute{
+							Description: "List of UUIDs for bindings the report group is for.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"source": schema.StringAttribute{
+							Description: "Type of the source for the report group.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "Notification schedule.",
+							Computed:    true,
+						},
+						"group_by": schema.ListAttribute{
+							Description: "Fields on which matching resources are grouped.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"use_message_settings": schema.BoolAttribute{
+							Description: "Whether to use delivery settings from the notification message.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *reportGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.ReportGroupsDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reportGroups, err := d.api.ReportGroup.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(reportGroups)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,116 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var _ datasource.DataSource = &repositoriesDataSource{}
+
+type repositoriesDataSource struct {
+	apiDataSource
+}
+
+func (d *repositoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+func (d *repositoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve repositories, optionally filtered. All filters are combined, and only repositories matching all of them are returned.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "Only return repositories with a URL for the host. Both standard and SCP-like (user@host:path) URLs are matched.",
+				Optional:    true,
+			},
+			"system": schema.BoolAttribute{
+				Description: "Only return system (or non-system) repositories.",
+				Optional:    true,
+			},
+			"repositories": schema.ListNestedAttribute{
+				Description: "The list of matching repositories.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL ID of the repository.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the repository.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "The URL of the repository.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the repository.",
+							Computed:    true,
+						},
+						"webhook_url": schema.StringAttribute{
+							Description: "The URL of the webhook which triggers repository scans.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the repository.",
+							Computed:    true,
+						},
+						"auth_user": schema.StringAttribute{
+							Description: "The user used to access the repository.",
+							Computed:    true,
+						},
+						"has_auth_token": schema.BoolAttribute{
+							Description: "Whether the repository has an auth token configured.",
+							Computed:    true,
+						},
+						"ssh_public_key": schema.StringAttribute{
+							Description: "If has_ssh_private_key, identifies that SSH private key.",
+							Computed:    true,
+						},
+						"has_ssh_private_key": schema.BoolAttribute{
+							Description: "Whether the repository has an SSH private key configured.",
+							Computed:    true,
+						},
+						"has_ssh_passphrase": schema.BoolAttribute{
+							Description: "Whether the repository has an SSH passphrase configured.",
+							Computed:    true,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether this is a system repository (not user editable).",
+							Computed:    true,
+						},
+						"role_assignment_target": schema.StringAttribute{
+							Description: "An opaque identifier for role assignments. Use this value when assigning roles to this resource.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.RepositoriesDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repos, err := d.api.Repository.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(repos)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
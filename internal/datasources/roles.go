@@ -0,0 +1,78 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var _ datasource.DataSource = &rolesDataSource{}
+
+type rolesDataSource struct {
+	apiDataSource
+}
+
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve all roles with their permissions, optionally only those including a permission.",
+		Attributes: map[string]schema.Attribute{
+			"permission": schema.StringAttribute{
+				Description: "Only return roles that include the permission.",
+				Optional:    true,
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "The list of matching roles.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the role.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the role.",
+							Computed:    true,
+						},
+						"permissions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Description: "The list of permissions granted by the role.",
+							Computed:    true,
+						},
+						"system": schema.BoolAttribute{
+							Description: "Whether this is a system role.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.RolesDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.api.Role.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(roles)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var _ datasource.DataSource = &userGroupsDataSource{}
+
+type userGroupsDataSource struct {
+	apiDataSource
+}
+
+func (d *userGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_groups"
+}
+
+func (d *userGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve all user groups.",
+		Attributes: map[string]schema.Attribute{
+			"user_groups": schema.ListNestedAttribute{
+				Description: "The list of matching user groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the user group.",
+							Computed:    true,
+						},
+						"uuid": schema.StringAttribute{
+							Description: "The UUID of the user group.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the user group.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the user group.",
+							Computed:    true,
+						},
+						"role_assignment_principal": schema.StringAttribute{
+							Description: "An opaque principal identifier for role assignments. Use this value when granting roles to the members of the group.",
+							Computed:    true,
+						},
+						"role_assignment_target": schema.StringAttribute{
+							Description: "An opaque target identifier for role assignments. Use this value when granting roles on the group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *userGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.UserGroupsDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroups, err := d.api.UserGroup.List(ctx)
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(userGroups)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,96 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var _ datasource.DataSource = &usersDataSource{}
+
+type usersDataSource struct {
+	apiDataSource
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve users, optionally filtered by whether they are active.",
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Description: "Only return active (or inactive) users.",
+				Optional:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The list of matching users.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The GraphQL Node ID of the user.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the user is active in the system.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The display name of the user.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email address of the user.",
+							Computed:    true,
+						},
+						"key": schema.Int64Attribute{
+							Description: "The numeric key identifier of the user.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the user.",
+							Computed:    true,
+						},
+						"role_assignment_principal": schema.StringAttribute{
+							Description: "An opaque principal identifier for role assignments. Use this value when creating role assignments.",
+							Computed:    true,
+						},
+						"sso_user": schema.BoolAttribute{
+							Description: "Whether the user is an SSO user.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "The username of the user.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data models.UsersDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.api.User.List(ctx, data.Filter())
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.Update(users)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
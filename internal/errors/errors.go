@@ -15,6 +15,30 @@ type DiagError interface {
 	Summary() string
 }
 
+// AttributeDiagError represents an error that refers to a specific attribute.
+type AttributeDiagError interface {
+	DiagError
+
+	// AttributePath returns the path of the attribute the error refers to,
+	// or an empty path if unknown
+	AttributePath() path.Path
+}
+
+// FieldAttributes maps API input fields, as the dot-separated path of nested
+// field names (e.g. "executionConfig.dryRun"), to the corresponding Terraform
+// attribute names (e.g. "dry_run").
+//
+// Fields mapped to an empty name are skipped in attribute paths. Attribute
+// paths stop at the first field that isn't mapped, so errors for unmapped
+// top-level fields aren't reported for an attribute.
+type FieldAttributes map[string]string
+
+// fieldAttributesMapper is an error that can refer to an API input field,
+// using a mapping to find the corresponding attribute.
+type fieldAttributesMapper interface {
+	WithFieldAttributes(fields map[string]string) error
+}
+
 // diagError wraps an error as a DiagError.
 type diagError struct {
 	err error
@@ -38,8 +62,24 @@ func AsDiagError(err error) DiagError {
 }
 
 // AddDiagError adds an error to the diagnostics.
+//
+// Errors wrapping multiple errors are reported as one diagnostic per error.
+// Errors referring to a specific attribute are reported for that attribute.
 func AddDiagError(diag *diag.Diagnostics, err error) {
+	if multi, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range multi.Unwrap() {
+			AddDiagError(diag, e)
+		}
+		return
+	}
+
 	e := AsDiagError(err)
+	if attrErr, ok := e.(AttributeDiagError); ok {
+		if p := attrErr.AttributePath(); len(p.Steps()) > 0 {
+			diag.AddAttributeError(p, e.Summary(), e.Error())
+			return
+		}
+	}
 	diag.AddError(e.Summary(), e.Error())
 }
 
@@ -49,3 +89,14 @@ func AddAttributeDiags(dest *diag.Diagnostics, src diag.Diagnostics, attr string
 		dest.Append(diag.WithPath(path.Root(attr), d))
 	}
 }
+
+// AddAttributeError adds an error to the diagnostics, like AddDiagError.
+//
+// Errors referring to an API input field are reported for the corresponding
+// attribute, based on the provided mapping.
+func AddAttributeError(diag *diag.Diagnostics, err error, fields FieldAttributes) {
+	if mapper, ok := err.(fieldAttributesMapper); ok {
+		err = mapper.WithFieldAttributes(fields)
+	}
+	AddDiagError(diag, err)
+}
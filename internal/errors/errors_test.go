@@ -0,0 +1,96 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package errors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testError struct {
+	summary string
+	message string
+	path    path.Path
+}
+
+func (e testError) Error() string {
+	return e.message
+}
+
+func (e testError) Summary() string {
+	return e.summary
+}
+
+func (e testError) AttributePath() path.Path {
+	return e.path
+}
+
+func TestAddDiagError(t *testing.T) {
+	var diags diag.Diagnostics
+	AddDiagError(&diags, errors.New("plain error"))
+
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.NewErrorDiagnostic("Error", "plain error"), diags[0])
+}
+
+func TestAddDiagError_Attribute(t *testing.T) {
+	var diags diag.Diagnostics
+	AddDiagError(&diags, testError{summary: "Invalid", message: "bad value", path: path.Root("name")})
+	AddDiagError(&diags, testError{summary: "Invalid", message: "no path", path: path.Empty()})
+
+	require.Len(t, diags, 2)
+	assert.Equal(t, diag.NewAttributeErrorDiagnostic(path.Root("name"), "Invalid", "bad value"), diags[0])
+	assert.Equal(t, diag.NewErrorDiagnostic("Invalid", "no path"), diags[1])
+}
+
+func TestAddDiagError_Multiple(t *testing.T) {
+	var diags diag.Diagnostics
+	AddDiagError(&diags, errors.Join(
+		testError{summary: "Invalid", message: "bad value", path: path.Root("name")},
+		testError{summary: "Conflict", message: "already exists", path: path.Empty()},
+	))
+
+	require.Len(t, diags, 2)
+	assert.Equal(t, diag.NewAttributeErrorDiagnostic(path.Root("name"), "Invalid", "bad value"), diags[0])
+	assert.Equal(t, diag.NewErrorDiagnostic("Conflict", "already exists"), diags[1])
+}
+
+type fieldError struct {
+	field  string
+	fields map[string]string
+}
+
+func (e fieldError) Error() string {
+	return "invalid field"
+}
+
+func (e fieldError) Summary() string {
+	return "Invalid Input"
+}
+
+func (e fieldError) AttributePath() path.Path {
+	if attr, ok := e.fields[e.field]; ok {
+		return path.Root(attr)
+	}
+	return path.Empty()
+}
+
+func (e fieldError) WithFieldAttributes(fields map[string]string) error {
+	e.fields = fields
+	return e
+}
+
+func TestAddAttributeError(t *testing.T) {
+	var diags diag.Diagnostics
+	AddAttributeError(&diags, fieldError{field: "provider"}, FieldAttributes{"provider": "cloud_provider"})
+	AddAttributeError(&diags, errors.New("plain error"), FieldAttributes{"provider": "cloud_provider"})
+
+	require.Len(t, diags, 2)
+	assert.Equal(t, diag.NewAttributeErrorDiagnostic(path.Root("cloud_provider"), "Invalid Input", "invalid field"), diags[0])
+	assert.Equal(t, diag.NewErrorDiagnostic("Error", "plain error"), diags[1])
+}
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -63,3 +64,74 @@ func (m *AccountResource) Update(account *api.Account) diag.Diagnostics {
 
 	return diags
 }
+
+// AccountsDataSource is the model for the accounts data source.
+type AccountsDataSource struct {
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Email         types.String `tfsdk:"email"`
+	Active        types.Bool   `tfsdk:"active"`
+	Tags          types.Map    `tfsdk:"tags"`
+	Accounts      types.List   `tfsdk:"accounts"`
+}
+
+// Filter returns the API filter for listing accounts.
+func (m AccountsDataSource) Filter() api.AccountsFilter {
+	return api.AccountsFilter{
+		Provider: api.CloudProvider(m.CloudProvider.ValueString()),
+		Email:    m.Email.ValueString(),
+		Active:   m.Active.ValueBoolPointer(),
+		Tags:     api.NewTagsList(m.Tags),
+	}
+}
+
+func (m *AccountsDataSource) Update(accounts []api.AccountListEntry) diag.Diagnostics {
+	accountsList, diags := typehelpers.ObjectList[AccountsItem](
+		accounts,
+		func(entry api.AccountListEntry) (map[string]attr.Value, diag.Diagnostics) {
+			tags, diags := entry.Tags.TagsMap()
+			return map[string]attr.Value{
+				"id":             typehelpers.GraphQLIDValue(entry.ID),
+				"key":            types.StringValue(entry.Key),
+				"name":           types.StringValue(entry.Name),
+				"short_name":     types.StringPointerValue(entry.ShortName),
+				"description":    types.StringPointerValue(entry.Description),
+				"cloud_provider": types.StringValue(string(entry.Provider)),
+				"path":           types.StringPointerValue(entry.Path),
+				"email":          types.StringPointerValue(entry.Email),
+				"active":         types.BoolValue(entry.Active),
+				"tags":           tags,
+			}, diags
+		},
+	)
+	m.Accounts = accountsList
+	return diags
+}
+
+// AccountsItem is an account in the accounts data source.
+type AccountsItem struct {
+	ID            types.String `tfsdk:"id"`
+	Key           types.String `tfsdk:"key"`
+	Name          types.String `tfsdk:"name"`
+	ShortName     types.String `tfsdk:"short_name"`
+	Description   types.String `tfsdk:"description"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Path          types.String `tfsdk:"path"`
+	Email         types.String `tfsdk:"email"`
+	Active        types.Bool   `tfsdk:"active"`
+	Tags          types.Map    `tfsdk:"tags"`
+}
+
+func (i AccountsItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.StringType,
+		"key":            types.StringType,
+		"name":           types.StringType,
+		"short_name":     types.StringType,
+		"description":    types.StringType,
+		"cloud_provider": types.StringType,
+		"path":           types.StringType,
+		"email":          types.StringType,
+		"active":         types.BoolType,
+		"tags":           types.MapType{ElemType: types.StringType},
+	}
+}
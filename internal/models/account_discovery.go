@@ -0,0 +1,144 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package models
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/typehelpers"
+)
+
+// AccountDiscoveryDataSource is the model for account discovery data sources.
+type AccountDiscoveryDataSource struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Suspended     types.Bool   `tfsdk:"suspended"`
+	AWS           types.Object `tfsdk:"aws"`
+	Azure         types.Object `tfsdk:"azure"`
+	GCP           types.Object `tfsdk:"gcp"`
+}
+
+func (m *AccountDiscoveryDataSource) Update(ctx context.Context, accountDiscovery *api.AccountDiscovery) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = typehelpers.GraphQLIDValue(accountDiscovery.ID)
+	m.Name = types.StringValue(accountDiscovery.Name)
+	m.Description = types.StringPointerValue(accountDiscovery.Description)
+	m.CloudProvider = types.StringValue(string(accountDiscovery.Provider))
+	m.Suspended = types.BoolValue(accountDiscovery.Schedule.Suspended)
+
+	awsConfig := accountDiscovery.AWSConfig()
+	aws, d := typehelpers.ObjectValue(
+		ctx,
+		awsConfig,
+		func() (*AccountDiscoveryAWSConfig, diag.Diagnostics) {
+			return &AccountDiscoveryAWSConfig{
+				OrgID:         types.StringValue(awsConfig.OrgID),
+				OrgReadRole:   types.StringValue(awsConfig.OrgRole),
+				MemberRole:    types.StringValue(awsConfig.MemberRole),
+				CustodianRole: types.StringValue(awsConfig.CustodianRole),
+			}, nil
+		},
+	)
+	errors.AddAttributeDiags(&diags, d, "aws")
+	m.AWS = aws
+
+	azureConfig := accountDiscovery.AzureConfig()
+	azure, d := typehelpers.ObjectValue(
+		ctx,
+		azureConfig,
+		func() (*AccountDiscoveryAzureConfig, diag.Diagnostics) {
+			return &AccountDiscoveryAzureConfig{
+				TenantID: types.StringValue(azureConfig.TenantID),
+				ClientID: types.StringValue(azureConfig.ClientID),
+			}, nil
+		},
+	)
+	errors.AddAttributeDiags(&diags, d, "azure")
+	m.Azure = azure
+
+	gcpConfig := accountDiscovery.GCPConfig()
+	gcp, d := typehelpers.ObjectValue(
+		ctx,
+		gcpConfig,
+		func() (*AccountDiscoveryGCPConfig, diag.Diagnostics) {
+			return &AccountDiscoveryGCPConfig{
+				OrgID:            types.StringValue(gcpConfig.OrgID),
+				RootFolderIDs:    typehelpers.StringsList(gcpConfig.RootFolderIDs),
+				ExcludeFolderIDs: typehelpers.StringsList(gcpConfig.ExcludeFolderIDs),
+				ClientEmail:      types.StringValue(gcpConfig.ClientEmail),
+				ClientID:         types.StringValue(gcpConfig.ClientID),
+				ProjectID:        types.StringValue(gcpConfig.ProjectID),
+				PrivateKeyID:     types.StringValue(gcpConfig.PrivateKeyID),
+			}, nil
+		},
+	)
+	errors.AddAttributeDiags(&diags, d, "gcp")
+	m.GCP = gcp
+
+	return diags
+}
+
+// AccountDiscoveryAWSConfig is the model for the configuration of an AWS
+// account discovery.
+type AccountDiscoveryAWSConfig struct {
+	OrgID         types.String `tfsdk:"org_id"`
+	OrgReadRole   types.String `tfsdk:"org_read_role"`
+	MemberRole    types.String `tfsdk:"member_role"`
+	CustodianRole types.String `tfsdk:"custodian_role"`
+}
+
+func (c AccountDiscoveryAWSConfig) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"org_id":         types.StringType,
+		"org_read_role":  types.StringType,
+		"member_role":    types.StringType,
+		"custodian_role": types.StringType,
+	}
+}
+
+// AccountDiscoveryAzureConfig is the model for the configuration of an Azure
+// account discovery.
+type AccountDiscoveryAzureConfig struct {
+	TenantID types.String `tfsdk:"tenant_id"`
+	ClientID types.String `tfsdk:"client_id"`
+}
+
+func (c AccountDiscoveryAzureConfig) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"tenant_id": types.StringType,
+		"client_id": types.StringType,
+	}
+}
+
+// AccountDiscoveryGCPConfig is the model for the configuration of a GCP
+// account discovery.
+type AccountDiscoveryGCPConfig struct {
+	OrgID            types.String `tfsdk:"org_id"`
+	RootFolderIDs    types.List   `tfsdk:"root_folder_ids"`
+	ExcludeFolderIDs types.List   `tfsdk:"exclude_folder_ids"`
+	ClientEmail      types.String `tfsdk:"client_email"`
+	ClientID         types.String `tfsdk:"client_id"`
+	ProjectID        types.String `tfsdk:"project_id"`
+	PrivateKeyID     types.String `tfsdk:"private_key_id"`
+}
+
+func (c AccountDiscoveryGCPConfig) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"org_id":             types.StringType,
+		"root_folder_ids":    types.ListType{ElemType: types.StringType},
+		"exclude_folder_ids": types.ListType{ElemType: types.StringType},
+		"client_email":       types.StringType,
+		"client_id":          types.StringType,
+		"project_id":         types.StringType,
+		"private_key_id":     types.StringType,
+	}
+}
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -20,6 +21,8 @@ type AccountDiscoveryAWSResource struct {
 	OrgReadRole   types.String `tfsdk:"org_read_role"`
 	MemberRole    types.String `tfsdk:"member_role"`
 	CustodianRole types.String `tfsdk:"custodian_role"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *AccountDiscoveryAWSResource) Update(accountDiscovery *api.AccountDiscovery) diag.Diagnostics {
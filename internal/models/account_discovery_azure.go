@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -19,6 +20,8 @@ type AccountDiscoveryAzureResource struct {
 	ClientID     types.String `tfsdk:"client_id"`
 	TenantID     types.String `tfsdk:"tenant_id"`
 	ClientSecret types.String `tfsdk:"client_secret_wo"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *AccountDiscoveryAzureResource) Update(accountDiscovery *api.AccountDiscovery) diag.Diagnostics {
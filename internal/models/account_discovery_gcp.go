@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -25,6 +26,8 @@ type AccountDiscoveryGCPResource struct {
 	PrivateKeyID          types.String `tfsdk:"private_key_id"`
 	CredentialJSON        types.String `tfsdk:"credential_json_wo"`
 	CredentialJSONVersion types.String `tfsdk:"credential_json_wo_version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *AccountDiscoveryGCPResource) Update(accountDiscovery *api.AccountDiscovery) diag.Diagnostics {
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -39,3 +40,61 @@ func (m *AccountGroupResource) Update(accountGroup *api.AccountGroup) diag.Diagn
 
 // AccountGroupDataSource is the model for account group data sources.
 type AccountGroupDataSource AccountGroupResource
+
+// AccountGroupsDataSource is the model for the account groups data source.
+type AccountGroupsDataSource struct {
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	AccountGroups types.List   `tfsdk:"account_groups"`
+}
+
+// Filter returns the API filter for listing account groups.
+func (m AccountGroupsDataSource) Filter() api.AccountGroupsFilter {
+	return api.AccountGroupsFilter{
+		Provider: api.CloudProvider(m.CloudProvider.ValueString()),
+	}
+}
+
+func (m *AccountGroupsDataSource) Update(accountGroups []api.AccountGroup) diag.Diagnostics {
+	groupsList, diags := typehelpers.ObjectList[AccountGroupsItem](
+		accountGroups,
+		func(group api.AccountGroup) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":                     typehelpers.GraphQLIDValue(group.ID),
+				"uuid":                   types.StringValue(group.UUID),
+				"name":                   types.StringValue(group.Name),
+				"description":            types.StringPointerValue(group.Description),
+				"dynamic_filter":         types.StringPointerValue(group.DynamicFilter),
+				"cloud_provider":         types.StringValue(group.Provider),
+				"regions":                typehelpers.StringsList(group.Regions),
+				"role_assignment_target": types.StringValue(group.RoleAssignmentTarget),
+			}, nil
+		},
+	)
+	m.AccountGroups = groupsList
+	return diags
+}
+
+// AccountGroupsItem is an account group in the account groups data source.
+type AccountGroupsItem struct {
+	ID                   types.String `tfsdk:"id"`
+	UUID                 types.String `tfsdk:"uuid"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	DynamicFilter        types.String `tfsdk:"dynamic_filter"`
+	CloudProvider        types.String `tfsdk:"cloud_provider"`
+	Regions              types.List   `tfsdk:"regions"`
+	RoleAssignmentTarget types.String `tfsdk:"role_assignment_target"`
+}
+
+func (i AccountGroupsItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                     types.StringType,
+		"uuid":                   types.StringType,
+		"name":                   types.StringType,
+		"description":            types.StringType,
+		"dynamic_filter":         types.StringType,
+		"cloud_provider":         types.StringType,
+		"regions":                types.ListType{ElemType: types.StringType},
+		"role_assignment_target": types.StringType,
+	}
+}
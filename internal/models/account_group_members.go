@@ -0,0 +1,37 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package models
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+	"github.com/stacklet/terraform-provider-stacklet/internal/typehelpers"
+)
+
+// AccountGroupMembersResource is the model for the account group members
+// resource.
+type AccountGroupMembersResource struct {
+	ID          types.String `tfsdk:"id"`
+	GroupUUID   types.String `tfsdk:"group_uuid"`
+	AccountKeys types.Set    `tfsdk:"account_keys"`
+}
+
+func (m *AccountGroupMembersResource) Update(groupUUID string, mappings []api.AccountGroupMapping) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountKeys := make([]string, len(mappings))
+	for i, mapping := range mappings {
+		accountKeys[i] = mapping.AccountKey
+	}
+	slices.Sort(accountKeys)
+
+	m.ID = types.StringValue(groupUUID)
+	m.GroupUUID = types.StringValue(groupUUID)
+	m.AccountKeys = typehelpers.StringsSet(accountKeys)
+
+	return diags
+}
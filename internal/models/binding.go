@@ -5,6 +5,7 @@ package models
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -89,6 +90,8 @@ type BindingResource struct {
 
 	SecurityContextWO        types.String `tfsdk:"security_context_wo"`
 	SecurityContextWOVersion types.String `tfsdk:"security_context_wo_version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *BindingResource) Update(ctx context.Context, binding *api.Binding) diag.Diagnostics {
@@ -141,3 +144,75 @@ func (c BindingExecutionConfigPolicyResourceLimit) AttributeTypes() map[string]a
 	m["policy_name"] = types.StringType
 	return m
 }
+
+// BindingsDataSource is the model for the bindings data source.
+type BindingsDataSource struct {
+	AccountGroupUUID     types.String `tfsdk:"account_group_uuid"`
+	PolicyCollectionUUID types.String `tfsdk:"policy_collection_uuid"`
+	AutoDeploy           types.Bool   `tfsdk:"auto_deploy"`
+	DryRun               types.Bool   `tfsdk:"dry_run"`
+	System               types.Bool   `tfsdk:"system"`
+	Bindings             types.List   `tfsdk:"bindings"`
+}
+
+// Filter returns the API filter for listing bindings.
+func (m BindingsDataSource) Filter() api.BindingsFilter {
+	return api.BindingsFilter{
+		AccountGroupUUID:     m.AccountGroupUUID.ValueString(),
+		PolicyCollectionUUID: m.PolicyCollectionUUID.ValueString(),
+		AutoDeploy:           m.AutoDeploy.ValueBoolPointer(),
+		DryRun:               m.DryRun.ValueBoolPointer(),
+		System:               m.System.ValueBoolPointer(),
+	}
+}
+
+func (m *BindingsDataSource) Update(bindings []api.Binding) diag.Diagnostics {
+	bindingsList, diags := typehelpers.ObjectList[BindingsItem](
+		bindings,
+		func(binding api.Binding) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":                     typehelpers.GraphQLIDValue(binding.ID),
+				"uuid":                   types.StringValue(binding.UUID),
+				"name":                   types.StringValue(binding.Name),
+				"description":            types.StringPointerValue(binding.Description),
+				"auto_deploy":            types.BoolValue(binding.AutoDeploy),
+				"schedule":               types.StringPointerValue(binding.Schedule),
+				"account_group_uuid":     types.StringValue(binding.AccountGroup.UUID),
+				"policy_collection_uuid": types.StringValue(binding.PolicyCollection.UUID),
+				"system":                 types.BoolValue(binding.System),
+				"dry_run":                types.BoolPointerValue(binding.DryRun()),
+			}, nil
+		},
+	)
+	m.Bindings = bindingsList
+	return diags
+}
+
+// BindingsItem is a binding in the bindings data source.
+type BindingsItem struct {
+	ID                   types.String `tfsdk:"id"`
+	UUID                 types.String `tfsdk:"uuid"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	AutoDeploy           types.Bool   `tfsdk:"auto_deploy"`
+	Schedule             types.String `tfsdk:"schedule"`
+	AccountGroupUUID     types.String `tfsdk:"account_group_uuid"`
+	PolicyCollectionUUID types.String `tfsdk:"policy_collection_uuid"`
+	System               types.Bool   `tfsdk:"system"`
+	DryRun               types.Bool   `tfsdk:"dry_run"`
+}
+
+func (i BindingsItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                     types.StringType,
+		"uuid":                   types.StringType,
+		"name":                   types.StringType,
+		"description":            types.StringType,
+		"auto_deploy":            types.BoolType,
+		"schedule":               types.StringType,
+		"account_group_uuid":     types.StringType,
+		"policy_collection_uuid": types.StringType,
+		"system":                 types.BoolType,
+		"dry_run":                types.BoolType,
+	}
+}
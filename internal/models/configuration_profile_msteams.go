@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -216,6 +217,8 @@ type ConfigurationProfileMSTeamsResource struct {
 
 	AccessConfigInput   types.Object `tfsdk:"access_config_input"`
 	CustomerConfigInput types.Object `tfsdk:"customer_config_input"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r ConfigurationProfileMSTeamsResource) AttributeTypes() map[string]attr.Type {
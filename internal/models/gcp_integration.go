@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -582,4 +583,6 @@ type GCPIntegrationResource struct {
 
 	CustomerConfigInput   types.Object `tfsdk:"customer_config_input"`
 	AccessConfigBlobInput types.String `tfsdk:"access_config_blob_input"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -35,3 +36,51 @@ func (m *NotificationTemplateResource) Update(template *api.Template) diag.Diagn
 type NotificationTemplateDataSource struct {
 	NotificationTemplateResource
 }
+
+// NotificationTemplatesDataSource is the model for the notification templates
+// data source.
+type NotificationTemplatesDataSource struct {
+	Transport types.String `tfsdk:"transport"`
+	Templates types.List   `tfsdk:"templates"`
+}
+
+// Filter returns the API filter for listing notification templates.
+func (m NotificationTemplatesDataSource) Filter() api.TemplatesFilter {
+	return api.TemplatesFilter{
+		Transport: m.Transport.ValueString(),
+	}
+}
+
+func (m *NotificationTemplatesDataSource) Update(templates []api.TemplateListEntry) diag.Diagnostics {
+	templatesList, diags := typehelpers.ObjectList[NotificationTemplatesItem](
+		templates,
+		func(template api.TemplateListEntry) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":          typehelpers.GraphQLIDValue(template.ID),
+				"name":        types.StringValue(template.Name),
+				"description": types.StringPointerValue(template.Description),
+				"transport":   types.StringPointerValue(template.Transport),
+			}, nil
+		},
+	)
+	m.Templates = templatesList
+	return diags
+}
+
+// NotificationTemplatesItem is a template in the notification templates data
+// source.
+type NotificationTemplatesItem struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Transport   types.String `tfsdk:"transport"`
+}
+
+func (i NotificationTemplatesItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"transport":   types.StringType,
+	}
+}
@@ -5,6 +5,7 @@ package models
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -55,3 +56,79 @@ func (m *PolicyDataSource) Update(ctx context.Context, policy *api.Policy) diag.
 
 	return diags
 }
+
+// PoliciesDataSource is the model for the policies data source.
+type PoliciesDataSource struct {
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Category      types.String `tfsdk:"category"`
+	Mode          types.String `tfsdk:"mode"`
+	System        types.Bool   `tfsdk:"system"`
+	Policies      types.List   `tfsdk:"policies"`
+}
+
+// Filter returns the API filter for listing policies.
+func (m PoliciesDataSource) Filter() api.PoliciesFilter {
+	return api.PoliciesFilter{
+		Provider: api.CloudProvider(m.CloudProvider.ValueString()),
+		Category: m.Category.ValueString(),
+		Mode:     m.Mode.ValueString(),
+		System:   m.System.ValueBoolPointer(),
+	}
+}
+
+func (m *PoliciesDataSource) Update(policies []api.PolicyListEntry) diag.Diagnostics {
+	policiesList, diags := typehelpers.ObjectList[PoliciesItem](
+		policies,
+		func(policy api.PolicyListEntry) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":               typehelpers.GraphQLIDValue(policy.ID),
+				"uuid":             types.StringValue(policy.UUID),
+				"name":             types.StringValue(policy.Name),
+				"unqualified_name": types.StringValue(policy.UnqualifiedName),
+				"version":          types.Int32Value(int32(policy.Version)),
+				"description":      types.StringPointerValue(policy.Description),
+				"cloud_provider":   types.StringValue(policy.Provider),
+				"category":         typehelpers.StringsList(policy.Category),
+				"mode":             types.StringValue(policy.Mode),
+				"resource_type":    types.StringValue(policy.ResourceType),
+				"path":             types.StringValue(policy.Path),
+				"system":           types.BoolValue(policy.System),
+			}, nil
+		},
+	)
+	m.Policies = policiesList
+	return diags
+}
+
+// PoliciesItem is a policy in the policies data source.
+type PoliciesItem struct {
+	ID              types.String `tfsdk:"id"`
+	UUID            types.String `tfsdk:"uuid"`
+	Name            types.String `tfsdk:"name"`
+	UnqualifiedName types.String `tfsdk:"unqualified_name"`
+	Version         types.Int32  `tfsdk:"version"`
+	Description     types.String `tfsdk:"description"`
+	CloudProvider   types.String `tfsdk:"cloud_provider"`
+	Category        types.List   `tfsdk:"category"`
+	Mode            types.String `tfsdk:"mode"`
+	ResourceType    types.String `tfsdk:"resource_type"`
+	Path            types.String `tfsdk:"path"`
+	System          types.Bool   `tfsdk:"system"`
+}
+
+func (i PoliciesItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":               types.StringType,
+		"uuid":             types.StringType,
+		"name":             types.StringType,
+		"unqualified_name": types.StringType,
+		"version":          types.Int32Type,
+		"description":      types.StringType,
+		"cloud_provider":   types.StringType,
+		"category":         types.ListType{ElemType: types.StringType},
+		"mode":             types.StringType,
+		"resource_type":    types.StringType,
+		"path":             types.StringType,
+		"system":           types.BoolType,
+	}
+}
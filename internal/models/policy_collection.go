@@ -83,3 +83,77 @@ func (c PolicyCollectionDynamicConfig) AttributeTypes() map[string]attr.Type {
 		"policy_file_suffixes": types.ListType{ElemType: types.StringType},
 	}
 }
+
+// PolicyCollectionsDataSource is the model for the policy collections data
+// source.
+type PolicyCollectionsDataSource struct {
+	CloudProvider     types.String `tfsdk:"cloud_provider"`
+	System            types.Bool   `tfsdk:"system"`
+	Dynamic           types.Bool   `tfsdk:"dynamic"`
+	RepositoryUUID    types.String `tfsdk:"repository_uuid"`
+	PolicyCollections types.List   `tfsdk:"policy_collections"`
+}
+
+// Filter returns the API filter for listing policy collections.
+func (m PolicyCollectionsDataSource) Filter() api.PolicyCollectionsFilter {
+	return api.PolicyCollectionsFilter{
+		Provider:       api.CloudProvider(m.CloudProvider.ValueString()),
+		System:         m.System.ValueBoolPointer(),
+		Dynamic:        m.Dynamic.ValueBoolPointer(),
+		RepositoryUUID: m.RepositoryUUID.ValueString(),
+	}
+}
+
+func (m *PolicyCollectionsDataSource) Update(ctx context.Context, policyCollections []api.PolicyCollection) diag.Diagnostics {
+	collectionsList, diags := typehelpers.ObjectList[PolicyCollectionsItem](
+		policyCollections,
+		func(policyCollection api.PolicyCollection) (map[string]attr.Value, diag.Diagnostics) {
+			var item PolicyCollectionResource
+			diags := item.Update(ctx, &policyCollection)
+			return map[string]attr.Value{
+				"id":                     item.ID,
+				"uuid":                   item.UUID,
+				"name":                   item.Name,
+				"description":            item.Description,
+				"cloud_provider":         item.CloudProvider,
+				"auto_update":            item.AutoUpdate,
+				"system":                 item.System,
+				"dynamic":                item.Dynamic,
+				"dynamic_config":         item.DynamicConfig,
+				"role_assignment_target": item.RoleAssignmentTarget,
+			}, diags
+		},
+	)
+	m.PolicyCollections = collectionsList
+	return diags
+}
+
+// PolicyCollectionsItem is a policy collection in the policy collections data
+// source.
+type PolicyCollectionsItem struct {
+	ID                   types.String `tfsdk:"id"`
+	UUID                 types.String `tfsdk:"uuid"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	CloudProvider        types.String `tfsdk:"cloud_provider"`
+	AutoUpdate           types.Bool   `tfsdk:"auto_update"`
+	System               types.Bool   `tfsdk:"system"`
+	Dynamic              types.Bool   `tfsdk:"dynamic"`
+	DynamicConfig        types.Object `tfsdk:"dynamic_config"`
+	RoleAssignmentTarget types.String `tfsdk:"role_assignment_target"`
+}
+
+func (i PolicyCollectionsItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                     types.StringType,
+		"uuid":                   types.StringType,
+		"name":                   types.StringType,
+		"description":            types.StringType,
+		"cloud_provider":         types.StringType,
+		"auto_update":            types.BoolType,
+		"system":                 types.BoolType,
+		"dynamic":                types.BoolType,
+		"dynamic_config":         types.ObjectType{AttrTypes: PolicyCollectionDynamicConfig{}.AttributeTypes()},
+		"role_assignment_target": types.StringType,
+	}
+}
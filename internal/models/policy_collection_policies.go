@@ -0,0 +1,95 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+)
+
+// PolicyCollectionPoliciesResource is the model for the policy collection
+// policies resource.
+type PolicyCollectionPoliciesResource struct {
+	ID             types.String `tfsdk:"id"`
+	CollectionUUID types.String `tfsdk:"collection_uuid"`
+	Policies       types.Set    `tfsdk:"policies"`
+}
+
+// Entries returns the policy entries for the collection, checking that each
+// policy is only included once.
+func (m PolicyCollectionPoliciesResource) Entries(ctx context.Context) ([]PolicyCollectionPoliciesEntry, diag.Diagnostics) {
+	entries := make([]PolicyCollectionPoliciesEntry, 0, len(m.Policies.Elements()))
+	diags := m.Policies.ElementsAs(ctx, &entries, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		policyUUID := entry.PolicyUUID.ValueString()
+		if seen[policyUUID] {
+			diags.AddAttributeError(
+				path.Root("policies"),
+				"Duplicate policy",
+				fmt.Sprintf("Policy %s is included more than once.", policyUUID),
+			)
+		}
+		seen[policyUUID] = true
+	}
+	return entries, diags
+}
+
+// Update sets the policies from the collection mappings.
+//
+// latestVersions maps UUIDs of policies tracking the latest version to their
+// latest version. For those, the version is left unset as long as the mapping
+// is for the latest version.
+func (m *PolicyCollectionPoliciesResource) Update(ctx context.Context, collectionUUID string, mappings []api.PolicyCollectionMapping, latestVersions map[string]int) diag.Diagnostics {
+	entries := make([]PolicyCollectionPoliciesEntry, len(mappings))
+	for i, mapping := range mappings {
+		version := types.Int32Value(int32(mapping.Policy.Version))
+		if latest, ok := latestVersions[mapping.Policy.UUID]; ok && latest == mapping.Policy.Version {
+			version = types.Int32Null()
+		}
+		entries[i] = PolicyCollectionPoliciesEntry{
+			PolicyUUID:    types.StringValue(mapping.Policy.UUID),
+			PolicyVersion: version,
+		}
+	}
+	slices.SortFunc(entries, func(a, b PolicyCollectionPoliciesEntry) int {
+		return strings.Compare(a.PolicyUUID.ValueString(), b.PolicyUUID.ValueString())
+	})
+
+	policies, diags := types.SetValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: PolicyCollectionPoliciesEntry{}.AttributeTypes()},
+		entries,
+	)
+	m.ID = types.StringValue(collectionUUID)
+	m.CollectionUUID = types.StringValue(collectionUUID)
+	m.Policies = policies
+	return diags
+}
+
+// PolicyCollectionPoliciesEntry is a policy in the policy collection policies
+// resource.
+type PolicyCollectionPoliciesEntry struct {
+	PolicyUUID    types.String `tfsdk:"policy_uuid"`
+	PolicyVersion types.Int32  `tfsdk:"policy_version"`
+}
+
+func (e PolicyCollectionPoliciesEntry) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"policy_uuid":    types.StringType,
+		"policy_version": types.Int32Type,
+	}
+}
@@ -235,6 +235,68 @@ type ReportGroupResource struct {
 	ReportGroupDataSource
 }
 
+// ReportGroupsDataSource is the model for the report groups data source.
+type ReportGroupsDataSource struct {
+	Source       types.String `tfsdk:"source"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	BindingUUID  types.String `tfsdk:"binding_uuid"`
+	ReportGroups types.List   `tfsdk:"report_groups"`
+}
+
+// Filter returns the API filter for listing report groups.
+func (m ReportGroupsDataSource) Filter() api.ReportGroupsFilter {
+	return api.ReportGroupsFilter{
+		Source:      api.ReportSource(m.Source.ValueString()),
+		Enabled:     m.Enabled.ValueBoolPointer(),
+		BindingUUID: m.BindingUUID.ValueString(),
+	}
+}
+
+func (m *ReportGroupsDataSource) Update(reportGroups []api.ReportGroupListEntry) diag.Diagnostics {
+	reportGroupsList, diags := typehelpers.ObjectList[ReportGroupsItem](
+		reportGroups,
+		func(rg api.ReportGroupListEntry) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":                   typehelpers.GraphQLIDValue(rg.ID),
+				"name":                 types.StringValue(rg.Name),
+				"enabled":              types.BoolValue(rg.Enabled),
+				"bindings":             typehelpers.StringsList(rg.Bindings),
+				"source":               types.StringValue(string(rg.Source)),
+				"schedule":             types.StringValue(rg.Schedule),
+				"group_by":             typehelpers.StringsList(rg.GroupBy),
+				"use_message_settings": types.BoolValue(rg.UseMessageSettings),
+			}, nil
+		},
+	)
+	m.ReportGroups = reportGroupsList
+	return diags
+}
+
+// ReportGroupsItem is a report group in the report groups data source.
+type ReportGroupsItem struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Bindings           types.List   `tfsdk:"bindings"`
+	Source             types.String `tfsdk:"source"`
+	Schedule           types.String `tfsdk:"schedule"`
+	GroupBy            types.List   `tfsdk:"group_by"`
+	UseMessageSettings types.Bool   `tfsdk:"use_message_settings"`
+}
+
+func (i ReportGroupsItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                   types.StringType,
+		"name":                 types.StringType,
+		"enabled":              types.BoolType,
+		"bindings":             types.ListType{ElemType: types.StringType},
+		"source":               types.StringType,
+		"schedule":             types.StringType,
+		"group_by":             types.ListType{ElemType: types.StringType},
+		"use_message_settings": types.BoolType,
+	}
+}
+
 // Recipient is the models for a notification recipient.
 type Recipient struct {
 	AccountOwner  types.Bool   `tfsdk:"account_owner"`
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -56,3 +57,64 @@ type RepositoryResource struct {
 	SSHPassphraseWO        types.String `tfsdk:"ssh_passphrase_wo"`
 	SSHPassphraseWOVersion types.String `tfsdk:"ssh_passphrase_wo_version"`
 }
+
+// RepositoriesDataSource is the model for the repositories data source.
+type RepositoriesDataSource struct {
+	Host         types.String `tfsdk:"host"`
+	System       types.Bool   `tfsdk:"system"`
+	Repositories types.List   `tfsdk:"repositories"`
+}
+
+// Filter returns the API filter for listing repositories.
+func (m RepositoriesDataSource) Filter() api.RepositoriesFilter {
+	return api.RepositoriesFilter{
+		Host:   m.Host.ValueString(),
+		System: m.System.ValueBoolPointer(),
+	}
+}
+
+func (m *RepositoriesDataSource) Update(repos []api.Repository) diag.Diagnostics {
+	reposList, diags := typehelpers.ObjectList[RepositoriesItem](
+		repos,
+		func(repo api.Repository) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":                     typehelpers.GraphQLIDValue(repo.ID),
+				"uuid":                   types.StringValue(repo.UUID),
+				"url":                    types.StringValue(repo.URL),
+				"name":                   types.StringValue(repo.Name),
+				"description":            types.StringPointerValue(repo.Description),
+				"webhook_url":            types.StringValue(repo.WebhookURL),
+				"system":                 types.BoolValue(repo.System),
+				"auth_user":              types.StringPointerValue(repo.Auth.AuthUser),
+				"has_auth_token":         types.BoolValue(repo.Auth.HasAuthToken),
+				"ssh_public_key":         types.StringPointerValue(repo.Auth.SSHPublicKey),
+				"has_ssh_private_key":    types.BoolValue(repo.Auth.HasSshPrivateKey),
+				"has_ssh_passphrase":     types.BoolValue(repo.Auth.HasSshPassphrase),
+				"role_assignment_target": types.StringValue(repo.RoleAssignmentTarget),
+			}, nil
+		},
+	)
+	m.Repositories = reposList
+	return diags
+}
+
+// RepositoriesItem is a repository in the repositories data source.
+type RepositoriesItem RepositoryDataSource
+
+func (i RepositoriesItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                     types.StringType,
+		"uuid":                   types.StringType,
+		"url":                    types.StringType,
+		"name":                   types.StringType,
+		"description":            types.StringType,
+		"webhook_url":            types.StringType,
+		"system":                 types.BoolType,
+		"auth_user":              types.StringType,
+		"has_auth_token":         types.BoolType,
+		"ssh_public_key":         types.StringType,
+		"has_ssh_private_key":    types.BoolType,
+		"has_ssh_passphrase":     types.BoolType,
+		"role_assignment_target": types.StringType,
+	}
+}
@@ -5,6 +5,7 @@ package models
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -34,3 +35,44 @@ func (m *RoleDataSource) Update(ctx context.Context, role *api.Role) diag.Diagno
 
 	return diags
 }
+
+// RolesDataSource is the model for the roles data source.
+type RolesDataSource struct {
+	Permission types.String `tfsdk:"permission"`
+	Roles      types.List   `tfsdk:"roles"`
+}
+
+// Filter returns the API filter for listing roles.
+func (m RolesDataSource) Filter() api.RolesFilter {
+	return api.RolesFilter{
+		Permission: m.Permission.ValueString(),
+	}
+}
+
+func (m *RolesDataSource) Update(roles []api.Role) diag.Diagnostics {
+	rolesList, diags := typehelpers.ObjectList[RolesItem](
+		roles,
+		func(role api.Role) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":          typehelpers.GraphQLIDValue(role.ID),
+				"name":        types.StringValue(role.Name),
+				"permissions": typehelpers.StringsList(role.Permissions),
+				"system":      types.BoolValue(role.System),
+			}, nil
+		},
+	)
+	m.Roles = rolesList
+	return diags
+}
+
+// RolesItem is a role in the roles data source.
+type RolesItem RoleDataSource
+
+func (i RolesItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"permissions": types.ListType{ElemType: types.StringType},
+		"system":      types.BoolType,
+	}
+}
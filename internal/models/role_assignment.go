@@ -4,6 +4,8 @@ package models
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -92,3 +94,69 @@ func (m *RoleAssignmentsDataSource) Update(ctx context.Context, assignments []ap
 
 	return diags
 }
+
+// RoleAssignmentsResource is the model for the role assignments resource.
+type RoleAssignmentsResource struct {
+	ID          types.String `tfsdk:"id"`
+	Target      types.String `tfsdk:"target"`
+	Assignments types.Set    `tfsdk:"assignments"`
+}
+
+// Grants returns the role grants for the target.
+func (m RoleAssignmentsResource) Grants(ctx context.Context) ([]api.RoleGrant, diag.Diagnostics) {
+	entries := make([]RoleAssignmentsEntry, 0, len(m.Assignments.Elements()))
+	diags := m.Assignments.ElementsAs(ctx, &entries, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	grants := make([]api.RoleGrant, len(entries))
+	for i, entry := range entries {
+		grants[i] = api.RoleGrant{
+			RoleName:  entry.RoleName.ValueString(),
+			Principal: entry.Principal.ValueString(),
+			Target:    m.Target.ValueString(),
+		}
+	}
+	return grants, diags
+}
+
+// Update sets the assignments from the API role assignments for the target.
+func (m *RoleAssignmentsResource) Update(ctx context.Context, target string, assignments []api.RoleAssignment) diag.Diagnostics {
+	entries := make([]RoleAssignmentsEntry, len(assignments))
+	for i, assignment := range assignments {
+		entries[i] = RoleAssignmentsEntry{
+			RoleName:  types.StringValue(assignment.Role.Name),
+			Principal: types.StringValue(assignment.GetPrincipal()),
+		}
+	}
+	slices.SortFunc(entries, func(a, b RoleAssignmentsEntry) int {
+		if c := strings.Compare(a.RoleName.ValueString(), b.RoleName.ValueString()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Principal.ValueString(), b.Principal.ValueString())
+	})
+
+	values, diags := types.SetValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: RoleAssignmentsEntry{}.AttributeTypes()},
+		entries,
+	)
+	m.ID = types.StringValue(target)
+	m.Target = types.StringValue(target)
+	m.Assignments = values
+	return diags
+}
+
+// RoleAssignmentsEntry is a role assignment in the role assignments resource.
+type RoleAssignmentsEntry struct {
+	RoleName  types.String `tfsdk:"role_name"`
+	Principal types.String `tfsdk:"principal"`
+}
+
+func (e RoleAssignmentsEntry) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"role_name": types.StringType,
+		"principal": types.StringType,
+	}
+}
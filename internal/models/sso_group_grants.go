@@ -0,0 +1,83 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package models
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+)
+
+// SSOGroupGrantsResource is the model for the SSO group grants resource.
+type SSOGroupGrantsResource struct {
+	ID              types.String `tfsdk:"id"`
+	SSOGroup        types.String `tfsdk:"sso_group"`
+	RoleAssignments types.Set    `tfsdk:"role_assignments"`
+}
+
+// Grants returns the role grants for the SSO group principal.
+func (m SSOGroupGrantsResource) Grants(ctx context.Context, principal string) ([]api.RoleGrant, diag.Diagnostics) {
+	entries := make([]SSOGroupGrantsRoleAssignment, 0, len(m.RoleAssignments.Elements()))
+	diags := m.RoleAssignments.ElementsAs(ctx, &entries, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	grants := make([]api.RoleGrant, len(entries))
+	for i, entry := range entries {
+		grants[i] = api.RoleGrant{
+			RoleName:  entry.RoleName.ValueString(),
+			Principal: principal,
+			Target:    entry.Target.ValueString(),
+		}
+	}
+	return grants, diags
+}
+
+// Update sets the role assignments for the SSO group.
+func (m *SSOGroupGrantsResource) Update(ctx context.Context, ssoGroup string, assignments []api.RoleAssignment) diag.Diagnostics {
+	entries := make([]SSOGroupGrantsRoleAssignment, len(assignments))
+	for i, assignment := range assignments {
+		entries[i] = SSOGroupGrantsRoleAssignment{
+			RoleName: types.StringValue(assignment.Role.Name),
+			Target:   types.StringValue(assignment.GetTarget()),
+		}
+	}
+	slices.SortFunc(entries, func(a, b SSOGroupGrantsRoleAssignment) int {
+		if c := strings.Compare(a.Target.ValueString(), b.Target.ValueString()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.RoleName.ValueString(), b.RoleName.ValueString())
+	})
+
+	roleAssignments, diags := types.SetValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: SSOGroupGrantsRoleAssignment{}.AttributeTypes()},
+		entries,
+	)
+
+	m.ID = types.StringValue(ssoGroup)
+	m.SSOGroup = types.StringValue(ssoGroup)
+	m.RoleAssignments = roleAssignments
+	return diags
+}
+
+// SSOGroupGrantsRoleAssignment is a role assignment in the SSO group grants
+// resource.
+type SSOGroupGrantsRoleAssignment struct {
+	RoleName types.String `tfsdk:"role_name"`
+	Target   types.String `tfsdk:"target"`
+}
+
+func (a SSOGroupGrantsRoleAssignment) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"role_name": types.StringType,
+		"target":    types.StringType,
+	}
+}
@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -43,3 +44,54 @@ func (m *UserDataSource) Update(user *api.User) diag.Diagnostics {
 type UserResource struct {
 	UserDataSource
 }
+
+// UsersDataSource is the model for the users data source.
+type UsersDataSource struct {
+	Active types.Bool `tfsdk:"active"`
+	Users  types.List `tfsdk:"users"`
+}
+
+// Filter returns the API filter for listing users.
+func (m UsersDataSource) Filter() api.UsersFilter {
+	return api.UsersFilter{
+		Active: m.Active.ValueBoolPointer(),
+	}
+}
+
+func (m *UsersDataSource) Update(users []api.User) diag.Diagnostics {
+	usersList, diags := typehelpers.ObjectList[UsersItem](
+		users,
+		func(user api.User) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":                        typehelpers.GraphQLIDValue(user.ID),
+				"active":                    types.BoolValue(user.Active),
+				"display_name":              types.StringPointerValue(user.DisplayName),
+				"email":                     types.StringPointerValue(user.Email),
+				"name":                      types.StringPointerValue(user.Name),
+				"key":                       types.Int64Value(user.Key),
+				"role_assignment_principal": types.StringValue(user.RoleAssignmentPrincipal),
+				"sso_user":                  types.BoolValue(user.SSOUser),
+				"username":                  types.StringPointerValue(user.Username),
+			}, nil
+		},
+	)
+	m.Users = usersList
+	return diags
+}
+
+// UsersItem is a user in the users data source.
+type UsersItem UserDataSource
+
+func (i UsersItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                        types.StringType,
+		"active":                    types.BoolType,
+		"display_name":              types.StringType,
+		"email":                     types.StringType,
+		"name":                      types.StringType,
+		"key":                       types.Int64Type,
+		"role_assignment_principal": types.StringType,
+		"sso_user":                  types.BoolType,
+		"username":                  types.StringType,
+	}
+}
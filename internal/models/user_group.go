@@ -3,6 +3,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -37,3 +38,40 @@ func (m *UserGroupDataSource) Update(userGroup *api.UserGroup) diag.Diagnostics
 type UserGroupResource struct {
 	UserGroupDataSource
 }
+
+// UserGroupsDataSource is the model for the user groups data source.
+type UserGroupsDataSource struct {
+	UserGroups types.List `tfsdk:"user_groups"`
+}
+
+func (m *UserGroupsDataSource) Update(userGroups []api.UserGroup) diag.Diagnostics {
+	groupsList, diags := typehelpers.ObjectList[UserGroupsItem](
+		userGroups,
+		func(userGroup api.UserGroup) (map[string]attr.Value, diag.Diagnostics) {
+			return map[string]attr.Value{
+				"id":                        typehelpers.GraphQLIDValue(userGroup.ID),
+				"uuid":                      types.StringValue(userGroup.UUID),
+				"name":                      types.StringValue(userGroup.Name),
+				"display_name":              types.StringPointerValue(userGroup.DisplayName),
+				"role_assignment_principal": types.StringValue(userGroup.RoleAssignmentPrincipal),
+				"role_assignment_target":    types.StringValue(userGroup.RoleAssignmentTarget),
+			}, nil
+		},
+	)
+	m.UserGroups = groupsList
+	return diags
+}
+
+// UserGroupsItem is a user group in the user groups data source.
+type UserGroupsItem UserGroupDataSource
+
+func (i UserGroupsItem) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                        types.StringType,
+		"uuid":                      types.StringType,
+		"name":                      types.StringType,
+		"display_name":              types.StringType,
+		"role_assignment_principal": types.StringType,
+		"role_assignment_target":    types.StringType,
+	}
+}
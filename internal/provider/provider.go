@@ -7,14 +7,21 @@ import (
 	"encoding/json"
 	"os"
 	"path"
+	"strings"
+	"time"
 
 	"github.com/caarlos0/env/v11"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/stacklet/terraform-provider-stacklet/internal/api"
@@ -22,22 +29,57 @@ import (
 	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
 	"github.com/stacklet/terraform-provider-stacklet/internal/providerdata"
 	"github.com/stacklet/terraform-provider-stacklet/internal/resources"
+	"github.com/stacklet/terraform-provider-stacklet/internal/schemavalidate"
 )
 
 var _ provider.Provider = &stackletProvider{}
 
 // providerModel holds the terraform configuration for the provider.
 type providerModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIKey   types.String `tfsdk:"api_key"`
+	Endpoint      types.String `tfsdk:"endpoint"`
+	APIKey        types.String `tfsdk:"api_key"`
+	APIKeyCommand types.List   `tfsdk:"api_key_command"`
+	ClientID      types.String `tfsdk:"client_id"`
+	ClientSecret  types.String `tfsdk:"client_secret"`
+	TokenURL      types.String `tfsdk:"token_url"`
+	Profile       types.String `tfsdk:"profile"`
+	ConfigDir     types.String `tfsdk:"config_dir"`
+	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait  types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait  types.String `tfsdk:"retry_max_wait"`
+
+	RateLimit             types.Float64 `tfsdk:"rate_limit"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestTimeout        types.String  `tfsdk:"request_timeout"`
+
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 // providerEnv holds environment variables supported by the provider.
 type providerEnv struct {
-	Endpoint           string `env:"STACKLET_ENDPOINT"`
-	APIKey             string `env:"STACKLET_API_KEY"`
-	PageSize           int    `env:"STACKLET_PAGE_SIZE" envDefault:"100"`
-	UnreleasedFeatures bool   `env:"STACKLET_UNRELEASED_FEATURES"`
+	Endpoint              string        `env:"STACKLET_ENDPOINT"`
+	APIKey                string        `env:"STACKLET_API_KEY"`
+	ClientID              string        `env:"STACKLET_CLIENT_ID"`
+	ClientSecret          string        `env:"STACKLET_CLIENT_SECRET"`
+	TokenURL              string        `env:"STACKLET_TOKEN_URL"`
+	Profile               string        `env:"STACKLET_PROFILE"`
+	ConfigDir             string        `env:"STACKLET_CONFIG_DIR"`
+	PageSize              int           `env:"STACKLET_PAGE_SIZE" envDefault:"100"`
+	MaxRetries            int           `env:"STACKLET_MAX_RETRIES" envDefault:"3"`
+	RetryMinWait          time.Duration `env:"STACKLET_RETRY_MIN_WAIT" envDefault:"1s"`
+	RetryMaxWait          time.Duration `env:"STACKLET_RETRY_MAX_WAIT" envDefault:"30s"`
+	RateLimit             float64       `env:"STACKLET_RATE_LIMIT"`
+	MaxConcurrentRequests int           `env:"STACKLET_MAX_CONCURRENT_REQUESTS"`
+	RequestTimeout        time.Duration `env:"STACKLET_REQUEST_TIMEOUT" envDefault:"5m"`
+	ProxyURL              string        `env:"STACKLET_PROXY_URL"`
+	CACertFile            string        `env:"STACKLET_CA_CERT_FILE"`
+	InsecureSkipVerify    bool          `env:"STACKLET_INSECURE_SKIP_VERIFY"`
+	UnreleasedFeatures    bool          `env:"STACKLET_UNRELEASED_FEATURES"`
 }
 
 type stackletProvider struct {
@@ -78,12 +120,219 @@ May also be provided via STACKLET_API_KEY environment variable, or from the stac
 `,
 				Optional:  true,
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						tfpath.MatchRoot("api_key_command"),
+						tfpath.MatchRoot("client_secret"),
+					),
+				},
+			},
+			"api_key_command": schema.ListAttribute{
+				Description: `
+A command returning the API key for Stacklet authentication on its standard output, as a list with the executable and its arguments (e.g. ["vault", "kv", "get", "-field=api_key", "secret/stacklet"]).
+
+The command is run the first time the API key is needed, and the key is reused for the lifetime of the provider. If the API rejects the key, the command is run again to get a new one.
+
+When set, it takes precedence over the STACKLET_API_KEY environment variable and the stacklet-admin CLI configuration.
+`,
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					listvalidator.ConflictsWith(tfpath.MatchRoot("client_secret")),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Description: `
+The OAuth client ID used to get access tokens via the client credentials grant, along with client_secret and token_url.
+
+When credentials are looked up from the stacklet-admin CLI configuration, it overrides the client ID used to refresh the login token.
+
+May also be provided via STACKLET_CLIENT_ID environment variable.
+`,
+				Optional: true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: `
+The OAuth client secret used to get access tokens via the client credentials grant, along with client_id and token_url.
+
+Tokens are refreshed transparently when they expire or are rejected by the API. When set, it takes precedence over the STACKLET_API_KEY environment variable and the stacklet-admin CLI configuration.
+
+May also be provided via STACKLET_CLIENT_SECRET environment variable.
+`,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_url": schema.StringAttribute{
+				Description: `
+The URL of the OAuth token endpoint used to get access tokens, via the client credentials grant when client_secret is set.
+
+When credentials are looked up from the stacklet-admin CLI configuration, it overrides the URL used to refresh the login token.
+
+May also be provided via STACKLET_TOKEN_URL environment variable.
+`,
+				Optional: true,
 			},
 			"endpoint": schema.StringAttribute{
 				Description: `
 The endpoint URL of the Stacklet GraphQL API.
 
  May also be provided via STACKLET_ENDPOINT environment variable, or from the stacklet-admin CLI configuration.
+`,
+				Optional: true,
+			},
+			"profile": schema.StringAttribute{
+				Description: `
+The name of the stacklet-admin CLI configuration profile to look up the endpoint and API key from.
+
+When set, the endpoint is read from the matching section under "profiles" in the config.json file, and the API key from the credentials.<profile> file. Otherwise the top-level configuration and the credentials file are used.
+
+A profile takes precedence over the STACKLET_ENDPOINT and STACKLET_API_KEY environment variables, and can't be combined with endpoint and API key settings in the provider configuration.
+
+May also be provided via STACKLET_PROFILE environment variable. In that case, endpoint and API key settings in the provider configuration take precedence over the profile.
+`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"config_dir": schema.StringAttribute{
+				Description: `
+The directory containing the stacklet-admin CLI configuration. Defaults to "~/.stacklet".
+
+May also be provided via STACKLET_CONFIG_DIR environment variable.
+`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: `
+The maximum number of times a failed API request is retried. Defaults to 3, set to 0 to disable retries.
+
+Queries are retried on connection errors and on throttling or gateway errors (HTTP 429, 502, 503 and 504). Mutations are only retried when the connection to the API can't be established, since the request has not been sent yet.
+
+May also be provided via STACKLET_MAX_RETRIES environment variable.
+`,
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.StringAttribute{
+				Description: `
+The minimum time to wait before retrying a failed API request, as a duration string (e.g. "500ms", "2s"). Defaults to "1s".
+
+The wait time doubles on each attempt, up to retry_max_wait, and is randomized between half and the full value so that concurrent requests don't retry at the same time. A Retry-After header returned by the API takes precedence, but is also capped at retry_max_wait.
+
+May also be provided via STACKLET_RETRY_MIN_WAIT environment variable.
+`,
+				Optional: true,
+				Validators: []validator.String{
+					schemavalidate.Duration(),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: `
+The maximum time to wait before retrying a failed API request, as a duration string (e.g. "30s", "1m"). Defaults to "30s".
+
+May also be provided via STACKLET_RETRY_MAX_WAIT environment variable.
+`,
+				Optional: true,
+				Validators: []validator.String{
+					schemavalidate.Duration(),
+				},
+			},
+			"rate_limit": schema.Float64Attribute{
+				Description: `
+The maximum number of API requests per second performed by the provider. By default requests are not rate limited.
+
+Useful to avoid platform throttling when running with high parallelism over many resources. Each retry of a failed request also counts against the limit.
+
+May also be provided via STACKLET_RATE_LIMIT environment variable.
+`,
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: `
+The maximum number of API requests performed concurrently by the provider. By default concurrent requests are not limited. Requests waiting to be retried don't count against the limit.
+
+May also be provided via STACKLET_MAX_CONCURRENT_REQUESTS environment variable.
+`,
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: `
+The maximum time an API request can take, as a duration string (e.g. "30s", "10m"). Defaults to "5m", set to "0s" to disable the timeout.
+
+The timeout covers the whole request, including retries and waiting for rate limits. It doesn't apply to resources supporting a timeouts block, which use the timeout configured for each operation instead.
+
+May also be provided via STACKLET_REQUEST_TIMEOUT environment variable.
+`,
+				Optional: true,
+				Validators: []validator.String{
+					schemavalidate.Duration(),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: `
+The URL of the proxy to use for API requests (e.g. "http://proxy.example.com:3128"). The http, https and socks5 schemes are supported.
+
+By default, the proxy is configured from the HTTPS_PROXY and NO_PROXY environment variables.
+
+May also be provided via STACKLET_PROXY_URL environment variable.
+`,
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: `
+The path to a PEM file with additional CA certificates to trust when connecting to the API, for instance for a private deployment or an inspecting proxy.
+
+May also be provided via STACKLET_CA_CERT_FILE environment variable.
+`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(tfpath.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: `
+Additional CA certificates to trust when connecting to the API, as PEM content. Alternative to ca_cert_file.
+`,
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: `
+The PEM-encoded client certificate for mutual TLS authentication with the API. Requires client_key.
+`,
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(tfpath.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: `
+The PEM-encoded private key for the client certificate. Requires client_cert.
+`,
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(tfpath.MatchRoot("client_cert")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: `
+Whether to skip verification of the API server certificate. This makes the connection insecure and should only be used for testing.
+
+May also be provided via STACKLET_INSECURE_SKIP_VERIFY environment variable.
 `,
 				Optional: true,
 			},
@@ -112,16 +361,56 @@ func (p *stackletProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	retry, diags := getRetryConfig(config, env)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	throttle := getThrottleConfig(config, env)
+
+	requestTimeout, diags := durationValue(config.RequestTimeout, "request_timeout", env.RequestTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transport, diags := getTransportConfig(config, env)
+	resp.Diagnostics.Append(diags...)
+
 	// Make provider data accessible to the Configure method of resources and data sources
-	providerData := providerdata.New(
+	providerData, err := providerdata.New(
 		ctx,
 		api.ClientConfig{
-			Endpoint: creds.Endpoint,
-			APIKey:   creds.APIKey,
-			Version:  p.version,
-			PageSize: env.PageSize,
+			Endpoint:      creds.Endpoint,
+			APIKey:        creds.APIKey,
+			APIKeyCommand: creds.APIKeyCommand,
+			TokenURL:      creds.TokenURL,
+			ClientID:      creds.ClientID,
+			ClientSecret:  creds.ClientSecret,
+			RefreshToken:  creds.RefreshToken,
+			Version:       p.version,
+			PageSize:      env.PageSize,
+			MaxRetries:    retry.MaxRetries,
+			RetryMinWait:  retry.MinWait,
+			RetryMaxWait:  retry.MaxWait,
+
+			RateLimit:             throttle.RateLimit,
+			MaxConcurrentRequests: throttle.MaxConcurrentRequests,
+			RequestTimeout:        requestTimeout,
+
+			ProxyURL:           transport.ProxyURL,
+			CACertFile:         transport.CACertFile,
+			CACertPEM:          transport.CACertPEM,
+			ClientCert:         transport.ClientCert,
+			ClientKey:          transport.ClientKey,
+			InsecureSkipVerify: transport.InsecureSkipVerify,
 		},
 	)
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
 	resp.ResourceData = providerData
 	resp.DataSourceData = providerData
 }
@@ -143,8 +432,18 @@ func envConfig() (providerEnv, error) {
 }
 
 type credentials struct {
-	Endpoint string
-	APIKey   string
+	Endpoint      string
+	APIKey        string
+	APIKeyCommand []string
+	TokenURL      string
+	ClientID      string
+	ClientSecret  string
+	RefreshToken  string
+}
+
+// hasAPIKey returns whether any kind of API key is set.
+func (c credentials) hasAPIKey() bool {
+	return c.APIKey != "" || len(c.APIKeyCommand) > 0 || c.ClientSecret != ""
 }
 
 func getCredentials(config providerModel, env providerEnv) (credentials, diag.Diagnostics) {
@@ -167,6 +466,14 @@ func getCredentials(config providerModel, env providerEnv) (credentials, diag.Di
 				"Either target apply the source of the value first, set the value statically in the configuration, or use the STACKLET_API_KEY environment variable.",
 		)
 	}
+	if config.APIKeyCommand.IsUnknown() {
+		diags.AddAttributeError(
+			tfpath.Root("api_key_command"),
+			"Unknown Stacklet API key command",
+			"The provider cannot create the Stacklet API client as there is an unknown configuration value for the Stacklet API key command. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
 	if diags.HasError() {
 		return creds, diags
 	}
@@ -174,37 +481,87 @@ func getCredentials(config providerModel, env providerEnv) (credentials, diag.Di
 	// lookup provider configuration (might return empty strings)
 	creds.Endpoint = config.Endpoint.ValueString()
 	creds.APIKey = config.APIKey.ValueString()
+	if !config.APIKeyCommand.IsNull() {
+		diags.Append(config.APIKeyCommand.ElementsAs(context.Background(), &creds.APIKeyCommand, false)...)
+		if diags.HasError() {
+			return creds, diags
+		}
+	}
+	creds.TokenURL = config.TokenURL.ValueString()
+	creds.ClientID = config.ClientID.ValueString()
+	creds.ClientSecret = config.ClientSecret.ValueString()
+	// a profile provides both the endpoint and the API key, so they can't also
+	// be configured along with it. A profile from the environment is only used
+	// for values not set in the configuration.
+	profile := adminCLIProfile(config, env)
+	if config.Profile.ValueString() != "" && (creds.Endpoint != "" || creds.hasAPIKey()) {
+		diags.AddAttributeError(
+			tfpath.Root("profile"),
+			"Conflicting Stacklet Credentials",
+			"The endpoint and API key are read from the \""+profile+"\" stacklet-admin CLI profile, and can't also be set in the provider configuration. "+
+				"Either remove the profile, or the endpoint and API key values from the configuration.",
+		)
+		return creds, diags
+	}
 
-	// lookup environment variables
-	if creds.Endpoint == "" {
-		creds.Endpoint = env.Endpoint
+	// lookup environment variables, the endpoint and API key only if not
+	// using a profile, since it takes precedence
+	if creds.TokenURL == "" {
+		creds.TokenURL = env.TokenURL
 	}
-	if creds.APIKey == "" {
-		creds.APIKey = env.APIKey
+	if creds.ClientID == "" {
+		creds.ClientID = env.ClientID
+	}
+	if profile == "" {
+		if creds.Endpoint == "" {
+			creds.Endpoint = env.Endpoint
+		}
+		if !creds.hasAPIKey() {
+			creds.ClientSecret = env.ClientSecret
+		}
+		if !creds.hasAPIKey() {
+			creds.APIKey = env.APIKey
+		}
 	}
 
 	// lookup stacklet-admin configuration
-	if homeDir, err := os.UserHomeDir(); err == nil {
+	if creds.Endpoint == "" || !creds.hasAPIKey() {
+		adminCreds, d := getAdminCLICredentials(config, env)
+		diags.Append(d...)
+		if diags.HasError() {
+			return creds, diags
+		}
 		if creds.Endpoint == "" {
-			configFile := path.Join(homeDir, ".stacklet", "config.json")
-			if content, err := os.ReadFile(configFile); err == nil {
-				config := struct {
-					Api string `json:"api"`
-				}{}
-				if err := json.Unmarshal(content, &config); err == nil {
-					creds.Endpoint = config.Api
-				}
-			}
+			creds.Endpoint = adminCreds.Endpoint
 		}
-
-		if creds.APIKey == "" {
-			credsFile := path.Join(homeDir, ".stacklet", "credentials")
-			if content, err := os.ReadFile(credsFile); err == nil {
-				creds.APIKey = string(content)
+		if !creds.hasAPIKey() {
+			creds.APIKey = adminCreds.APIKey
+			creds.RefreshToken = adminCreds.RefreshToken
+			if creds.TokenURL == "" {
+				creds.TokenURL = adminCreds.TokenURL
+			}
+			if creds.ClientID == "" {
+				creds.ClientID = adminCreds.ClientID
 			}
 		}
 	}
 
+	return creds, validateCredentials(creds)
+}
+
+// validateCredentials checks that the credentials are complete.
+func validateCredentials(creds credentials) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if creds.ClientSecret != "" && (creds.ClientID == "" || creds.TokenURL == "") {
+		diags.AddAttributeError(
+			tfpath.Root("client_secret"),
+			"Incomplete Stacklet OAuth Client Credentials",
+			"The provider cannot create the Stacklet API client as the OAuth client credentials are incomplete. "+
+				"Set the client_id and token_url values in the configuration, or in the STACKLET_CLIENT_ID and STACKLET_TOKEN_URL environment variables.",
+		)
+	}
+
 	if creds.Endpoint == "" {
 		diags.AddAttributeError(
 			tfpath.Root("endpoint"),
@@ -213,7 +570,7 @@ func getCredentials(config providerModel, env providerEnv) (credentials, diag.Di
 				"Set the endpoint value in the configuration, in the STACKLET_ENDPOINT environment variable, or login via the stacklet-admin CLI first.",
 		)
 	}
-	if creds.APIKey == "" {
+	if !creds.hasAPIKey() {
 		diags.AddAttributeError(
 			tfpath.Root("api_key"),
 			"Missing Stacklet API key",
@@ -221,5 +578,207 @@ func getCredentials(config providerModel, env providerEnv) (credentials, diag.Di
 				"Set the api_key value in the configuration, in the STACKLET_API_KEY environment variable, or login via the stacklet-admin CLI first.",
 		)
 	}
+	return diags
+}
+
+// adminCLIConfig is the content of the stacklet-admin CLI config.json file.
+type adminCLIConfig struct {
+	Api             string                    `json:"api"`
+	AuthURL         string                    `json:"auth_url"`
+	CognitoClientID string                    `json:"cognito_client_id"`
+	Profiles        map[string]adminCLIConfig `json:"profiles"`
+}
+
+// adminCLICredentials is the content of the stacklet-admin CLI credentials
+// file, when it includes a refresh token. Otherwise the file only contains the
+// access token.
+type adminCLICredentials struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// adminCLIProfile returns the name of the stacklet-admin CLI profile to use,
+// or an empty string for the default configuration.
+func adminCLIProfile(config providerModel, env providerEnv) string {
+	if !config.Profile.IsNull() && !config.Profile.IsUnknown() {
+		return config.Profile.ValueString()
+	}
+	return env.Profile
+}
+
+// getAdminCLICredentials looks up credentials from the stacklet-admin CLI
+// configuration, optionally for a named profile.
+func getAdminCLICredentials(config providerModel, env providerEnv) (credentials, diag.Diagnostics) {
+	var creds credentials
+	var diags diag.Diagnostics
+
+	profile := adminCLIProfile(config, env)
+	configDir := env.ConfigDir
+	if !config.ConfigDir.IsNull() && !config.ConfigDir.IsUnknown() {
+		configDir = config.ConfigDir.ValueString()
+	}
+	if configDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return creds, diags
+		}
+		configDir = path.Join(homeDir, ".stacklet")
+	}
+
+	var adminConfig adminCLIConfig
+	if content, err := os.ReadFile(path.Join(configDir, "config.json")); err == nil {
+		_ = json.Unmarshal(content, &adminConfig)
+	}
+	credsFile := "credentials"
+	if profile != "" {
+		profileConfig, ok := adminConfig.Profiles[profile]
+		if !ok {
+			diags.AddAttributeError(
+				tfpath.Root("profile"),
+				"Unknown Stacklet Profile",
+				"The profile \""+profile+"\" is not defined in the stacklet-admin CLI configuration in "+configDir+".",
+			)
+			return creds, diags
+		}
+		adminConfig = profileConfig
+		credsFile += "." + profile
+	}
+	creds.Endpoint = adminConfig.Api
+
+	if content, err := os.ReadFile(path.Join(configDir, credsFile)); err == nil {
+		var adminCreds adminCLICredentials
+		if err := json.Unmarshal(content, &adminCreds); err == nil {
+			creds.APIKey = adminCreds.AccessToken
+			creds.RefreshToken = adminCreds.RefreshToken
+		} else {
+			creds.APIKey = string(content)
+		}
+	}
+	if creds.RefreshToken != "" {
+		creds.ClientID = adminConfig.CognitoClientID
+		if adminConfig.AuthURL != "" {
+			creds.TokenURL = strings.TrimSuffix(adminConfig.AuthURL, "/") + "/oauth2/token"
+		}
+	}
 	return creds, diags
 }
+
+type retryConfig struct {
+	MaxRetries int
+	MinWait    time.Duration
+	MaxWait    time.Duration
+}
+
+func getRetryConfig(config providerModel, env providerEnv) (retryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	retry := retryConfig{
+		MaxRetries: env.MaxRetries,
+		MinWait:    env.RetryMinWait,
+		MaxWait:    env.RetryMaxWait,
+	}
+
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		retry.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	var d diag.Diagnostics
+	retry.MinWait, d = durationValue(config.RetryMinWait, "retry_min_wait", retry.MinWait)
+	diags.Append(d...)
+	retry.MaxWait, d = durationValue(config.RetryMaxWait, "retry_max_wait", retry.MaxWait)
+	diags.Append(d...)
+	if diags.HasError() {
+		return retry, diags
+	}
+
+	if retry.MinWait > retry.MaxWait {
+		diags.AddAttributeError(
+			tfpath.Root("retry_min_wait"),
+			"Invalid Retry Wait Time",
+			"The minimum retry wait time ("+retry.MinWait.String()+") must not be greater than the maximum one ("+retry.MaxWait.String()+").",
+		)
+	}
+	return retry, diags
+}
+
+type throttleConfig struct {
+	RateLimit             float64
+	MaxConcurrentRequests int
+}
+
+func getThrottleConfig(config providerModel, env providerEnv) throttleConfig {
+	throttle := throttleConfig{
+		RateLimit:             env.RateLimit,
+		MaxConcurrentRequests: env.MaxConcurrentRequests,
+	}
+
+	if !config.RateLimit.IsNull() && !config.RateLimit.IsUnknown() {
+		throttle.RateLimit = config.RateLimit.ValueFloat64()
+	}
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		throttle.MaxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+	return throttle
+}
+
+type transportConfig struct {
+	ProxyURL           string
+	CACertFile         string
+	CACertPEM          string
+	ClientCert         string
+	ClientKey          string
+	InsecureSkipVerify bool
+}
+
+func getTransportConfig(config providerModel, env providerEnv) (transportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := transportConfig{
+		ProxyURL:           env.ProxyURL,
+		CACertFile:         env.CACertFile,
+		CACertPEM:          config.CACertPEM.ValueString(),
+		ClientCert:         config.ClientCert.ValueString(),
+		ClientKey:          config.ClientKey.ValueString(),
+		InsecureSkipVerify: env.InsecureSkipVerify,
+	}
+
+	if !config.ProxyURL.IsNull() && !config.ProxyURL.IsUnknown() {
+		transport.ProxyURL = config.ProxyURL.ValueString()
+	}
+	if !config.CACertFile.IsNull() && !config.CACertFile.IsUnknown() {
+		transport.CACertFile = config.CACertFile.ValueString()
+	}
+	if transport.CACertPEM != "" {
+		// the PEM content from the configuration takes precedence over the file from the environment
+		transport.CACertFile = ""
+	}
+	if !config.InsecureSkipVerify.IsNull() && !config.InsecureSkipVerify.IsUnknown() {
+		transport.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	if transport.InsecureSkipVerify {
+		diags.AddAttributeWarning(
+			tfpath.Root("insecure_skip_verify"),
+			"Insecure TLS Connection",
+			"Verification of the Stacklet API server certificate is disabled. "+
+				"The connection is vulnerable to man-in-the-middle attacks, which could expose credentials and data. "+
+				"Only use this setting for testing, and configure ca_cert_file or ca_cert_pem to trust a private CA instead.",
+		)
+	}
+	return transport, diags
+}
+
+// durationValue returns the duration from a configuration string, or the
+// fallback if the value is not set.
+func durationValue(value types.String, attr string, fallback time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() {
+		return fallback, diags
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(tfpath.Root(attr), "Invalid Duration", err.Error())
+		return fallback, diags
+	}
+	return d, diags
+}
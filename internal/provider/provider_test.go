@@ -7,7 +7,9 @@ import (
 	"os"
 	"path"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -208,3 +210,460 @@ func TestGetCredentials_InvalidAdminCLIConfigJSON(t *testing.T) {
 	require.True(t, diags.HasError())
 	assert.Contains(t, diags[0].Summary(), "Missing Stacklet API Endpoint")
 }
+
+func TestGetCredentials_APIKeyCommand(t *testing.T) {
+	setupStackletAdminConfig(t, "https://cli-endpoint.example.com", "cli-api-key")
+
+	command := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("get-key"),
+		types.StringValue("--raw"),
+	})
+	creds, diags := getCredentials(
+		providerModel{APIKeyCommand: command},
+		providerEnv{APIKey: "env-api-key"},
+	)
+	require.False(t, diags.HasError())
+	assert.Equal(t, credentials{
+		Endpoint:      "https://cli-endpoint.example.com",
+		APIKeyCommand: []string{"get-key", "--raw"},
+	}, creds)
+
+	_, diags = getCredentials(
+		providerModel{APIKeyCommand: types.ListUnknown(types.StringType)},
+		providerEnv{},
+	)
+	require.Len(t, diags.Errors(), 1)
+	assert.Equal(t, "Unknown Stacklet API key command", diags[0].Summary())
+}
+
+func TestGetCredentials_ClientCredentials(t *testing.T) {
+	setupStackletAdminConfig(t, "https://cli-endpoint.example.com", "cli-api-key")
+
+	creds, diags := getCredentials(
+		providerModel{
+			ClientID:     types.StringValue("config-client"),
+			ClientSecret: types.StringValue("config-secret"),
+		},
+		providerEnv{
+			APIKey:   "env-api-key",
+			TokenURL: "https://auth.example.com/oauth2/token",
+		},
+	)
+	require.False(t, diags.HasError())
+	assert.Equal(t, credentials{
+		Endpoint:     "https://cli-endpoint.example.com",
+		TokenURL:     "https://auth.example.com/oauth2/token",
+		ClientID:     "config-client",
+		ClientSecret: "config-secret",
+	}, creds)
+
+	// the client ID can come from the environment
+	creds, diags = getCredentials(
+		providerModel{
+			ClientSecret: types.StringValue("config-secret"),
+		},
+		providerEnv{
+			ClientID: "env-client",
+			TokenURL: "https://auth.example.com/oauth2/token",
+		},
+	)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "env-client", creds.ClientID)
+	assert.Equal(t, "config-secret", creds.ClientSecret)
+
+	_, diags = getCredentials(
+		providerModel{
+			ClientID:     types.StringValue("config-client"),
+			ClientSecret: types.StringValue("config-secret"),
+		},
+		providerEnv{},
+	)
+	require.Len(t, diags.Errors(), 1)
+	assert.Equal(t, "Incomplete Stacklet OAuth Client Credentials", diags[0].Summary())
+}
+
+func TestGetCredentials_AdminCLIRefreshToken(t *testing.T) {
+	stackletDir := makeStackletAdminDir(t)
+	configJSON, _ := json.Marshal(map[string]string{
+		"api":               "https://cli-endpoint.example.com",
+		"auth_url":          "https://auth.example.com/",
+		"cognito_client_id": "cli-client",
+	})
+	if err := os.WriteFile(path.Join(stackletDir, "config.json"), configJSON, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	credsJSON, _ := json.Marshal(map[string]string{
+		"access_token":  "cli-access-token",
+		"refresh_token": "cli-refresh-token",
+	})
+	if err := os.WriteFile(path.Join(stackletDir, "credentials"), credsJSON, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	creds, diags := getCredentials(providerModel{}, providerEnv{})
+	require.False(t, diags.HasError())
+	assert.Equal(t, credentials{
+		Endpoint:     "https://cli-endpoint.example.com",
+		APIKey:       "cli-access-token",
+		TokenURL:     "https://auth.example.com/oauth2/token",
+		ClientID:     "cli-client",
+		RefreshToken: "cli-refresh-token",
+	}, creds)
+}
+
+func setupStackletAdminProfiles(t *testing.T, stackletDir string, profiles map[string]credentials) {
+	configData := map[string]any{"api": "https://cli-endpoint.example.com"}
+	profilesData := map[string]any{}
+	for name, creds := range profiles {
+		profilesData[name] = map[string]string{"api": creds.Endpoint}
+		credsFile := path.Join(stackletDir, "credentials."+name)
+		if err := os.WriteFile(credsFile, []byte(creds.APIKey), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	configData["profiles"] = profilesData
+	configJSON, _ := json.Marshal(configData)
+	if err := os.WriteFile(path.Join(stackletDir, "config.json"), configJSON, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(stackletDir, "credentials"), []byte("cli-api-key"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetCredentials_Profile(t *testing.T) {
+	profiles := map[string]credentials{
+		"prod": {
+			Endpoint: "https://prod-endpoint.example.com",
+			APIKey:   "prod-api-key",
+		},
+		"staging": {
+			Endpoint: "https://staging-endpoint.example.com",
+			APIKey:   "staging-api-key",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		env        providerEnv
+		config     providerModel
+		expected   credentials
+		diagErrors []string
+	}{
+		{
+			name: "NoProfile",
+			expected: credentials{
+				Endpoint: "https://cli-endpoint.example.com",
+				APIKey:   "cli-api-key",
+			},
+		},
+		{
+			name: "FromConfig",
+			config: providerModel{
+				Profile: types.StringValue("prod"),
+			},
+			expected: profiles["prod"],
+		},
+		{
+			name: "FromEnviron",
+			env: providerEnv{
+				Profile: "staging",
+			},
+			expected: profiles["staging"],
+		},
+		{
+			name: "ConfigOverridesEnviron",
+			env: providerEnv{
+				Profile: "staging",
+			},
+			config: providerModel{
+				Profile: types.StringValue("prod"),
+			},
+			expected: profiles["prod"],
+		},
+		{
+			name: "ProfileOverridesEnvironCredentials",
+			env: providerEnv{
+				Endpoint: "https://env-endpoint.example.com",
+				APIKey:   "env-api-key",
+			},
+			config: providerModel{
+				Profile: types.StringValue("prod"),
+			},
+			expected: profiles["prod"],
+		},
+		{
+			name: "ConflictingConfigEndpoint",
+			config: providerModel{
+				Profile:  types.StringValue("prod"),
+				Endpoint: types.StringValue("https://config-endpoint.example.com"),
+			},
+			diagErrors: []string{"Conflicting Stacklet Credentials"},
+		},
+		{
+			name: "ConflictingConfigAPIKey",
+			config: providerModel{
+				Profile: types.StringValue("prod"),
+				APIKey:  types.StringValue("config-api-key"),
+			},
+			diagErrors: []string{"Conflicting Stacklet Credentials"},
+		},
+		{
+			name: "ConfigAPIKeyOverridesEnvironProfile",
+			env: providerEnv{
+				Profile: "staging",
+			},
+			config: providerModel{
+				APIKey: types.StringValue("config-api-key"),
+			},
+			expected: credentials{
+				Endpoint: "https://staging-endpoint.example.com",
+				APIKey:   "config-api-key",
+			},
+		},
+		{
+			name: "ConfigEndpointOverridesEnvironProfile",
+			env: providerEnv{
+				Profile: "staging",
+			},
+			config: providerModel{
+				Endpoint: types.StringValue("https://config-endpoint.example.com"),
+			},
+			expected: credentials{
+				Endpoint: "https://config-endpoint.example.com",
+				APIKey:   "staging-api-key",
+			},
+		},
+		{
+			name: "ProfileKeepsConfigClientIDAndTokenURL",
+			env: providerEnv{
+				TokenURL: "https://env-auth.example.com/oauth2/token",
+			},
+			config: providerModel{
+				Profile:  types.StringValue("prod"),
+				ClientID: types.StringValue("config-client"),
+			},
+			expected: credentials{
+				Endpoint: "https://prod-endpoint.example.com",
+				APIKey:   "prod-api-key",
+				TokenURL: "https://env-auth.example.com/oauth2/token",
+				ClientID: "config-client",
+			},
+		},
+		{
+			name: "UnknownProfile",
+			config: providerModel{
+				Profile: types.StringValue("sandbox"),
+			},
+			diagErrors: []string{"Unknown Stacklet Profile"},
+		},
+		{
+			name: "UnknownProfileWithEnvironCredentials",
+			env: providerEnv{
+				Endpoint: "https://env-endpoint.example.com",
+				APIKey:   "env-api-key",
+			},
+			config: providerModel{
+				Profile: types.StringValue("sandbox"),
+			},
+			diagErrors: []string{"Unknown Stacklet Profile"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stackletDir := makeStackletAdminDir(t)
+			setupStackletAdminProfiles(t, stackletDir, profiles)
+
+			creds, diags := getCredentials(tc.config, tc.env)
+			expectedErrors := len(tc.diagErrors)
+			if expectedErrors == 0 {
+				assert.Equal(t, tc.expected, creds)
+			}
+			require.Len(t, diags.Errors(), expectedErrors)
+			for i, msg := range tc.diagErrors {
+				assert.Contains(t, diags[i].Summary(), msg)
+			}
+		})
+	}
+}
+
+func TestGetCredentials_ConfigDir(t *testing.T) {
+	setHomeDir(t)
+	configDir := t.TempDir()
+	setupStackletAdminProfiles(t, configDir, map[string]credentials{
+		"prod": {
+			Endpoint: "https://prod-endpoint.example.com",
+			APIKey:   "prod-api-key",
+		},
+	})
+
+	creds, diags := getCredentials(providerModel{ConfigDir: types.StringValue(configDir)}, providerEnv{})
+	require.False(t, diags.HasError())
+	assert.Equal(t, credentials{Endpoint: "https://cli-endpoint.example.com", APIKey: "cli-api-key"}, creds)
+
+	creds, diags = getCredentials(providerModel{}, providerEnv{ConfigDir: configDir, Profile: "prod"})
+	require.False(t, diags.HasError())
+	assert.Equal(t, credentials{Endpoint: "https://prod-endpoint.example.com", APIKey: "prod-api-key"}, creds)
+}
+
+func TestGetRetryConfig(t *testing.T) {
+	envDefault := providerEnv{
+		MaxRetries:   3,
+		RetryMinWait: time.Second,
+		RetryMaxWait: 30 * time.Second,
+	}
+
+	tests := []struct {
+		name       string
+		env        providerEnv
+		config     providerModel
+		expected   retryConfig
+		diagErrors []string
+	}{
+		{
+			name: "Defaults",
+			env:  envDefault,
+			expected: retryConfig{
+				MaxRetries: 3,
+				MinWait:    time.Second,
+				MaxWait:    30 * time.Second,
+			},
+		},
+		{
+			name: "FromEnviron",
+			env: providerEnv{
+				MaxRetries:   5,
+				RetryMinWait: 2 * time.Second,
+				RetryMaxWait: time.Minute,
+			},
+			expected: retryConfig{
+				MaxRetries: 5,
+				MinWait:    2 * time.Second,
+				MaxWait:    time.Minute,
+			},
+		},
+		{
+			name: "ConfigOverridesEnviron",
+			env:  envDefault,
+			config: providerModel{
+				MaxRetries:   types.Int64Value(0),
+				RetryMinWait: types.StringValue("500ms"),
+				RetryMaxWait: types.StringValue("5s"),
+			},
+			expected: retryConfig{
+				MaxRetries: 0,
+				MinWait:    500 * time.Millisecond,
+				MaxWait:    5 * time.Second,
+			},
+		},
+		{
+			name: "InvalidDuration",
+			env:  envDefault,
+			config: providerModel{
+				RetryMaxWait: types.StringValue("forever"),
+			},
+			diagErrors: []string{"Invalid Duration"},
+		},
+		{
+			name: "MinGreaterThanMax",
+			env:  envDefault,
+			config: providerModel{
+				RetryMinWait: types.StringValue("1m"),
+			},
+			diagErrors: []string{"Invalid Retry Wait Time"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			retry, diags := getRetryConfig(tc.config, tc.env)
+			expectedErrors := len(tc.diagErrors)
+			if expectedErrors == 0 {
+				assert.Equal(t, tc.expected, retry)
+			}
+			require.Len(t, diags.Errors(), expectedErrors)
+			for i, msg := range tc.diagErrors {
+				assert.Contains(t, diags[i].Summary(), msg)
+			}
+		})
+	}
+}
+
+func TestGetThrottleConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      providerEnv
+		config   providerModel
+		expected throttleConfig
+	}{
+		{
+			name:     "Defaults",
+			expected: throttleConfig{},
+		},
+		{
+			name: "FromEnviron",
+			env: providerEnv{
+				RateLimit:             5,
+				MaxConcurrentRequests: 4,
+			},
+			expected: throttleConfig{
+				RateLimit:             5,
+				MaxConcurrentRequests: 4,
+			},
+		},
+		{
+			name: "ConfigOverridesEnviron",
+			env: providerEnv{
+				RateLimit:             5,
+				MaxConcurrentRequests: 4,
+			},
+			config: providerModel{
+				RateLimit:             types.Float64Value(0.5),
+				MaxConcurrentRequests: types.Int64Value(0),
+			},
+			expected: throttleConfig{
+				RateLimit:             0.5,
+				MaxConcurrentRequests: 0,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, getThrottleConfig(tc.config, tc.env))
+		})
+	}
+}
+
+func TestGetTransportConfig(t *testing.T) {
+	env := providerEnv{
+		ProxyURL:   "http://env-proxy.example.com:3128",
+		CACertFile: "/env/ca.pem",
+	}
+
+	transport, diags := getTransportConfig(providerModel{}, env)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, diags.Warnings())
+	assert.Equal(t, transportConfig{
+		ProxyURL:   "http://env-proxy.example.com:3128",
+		CACertFile: "/env/ca.pem",
+	}, transport)
+
+	transport, diags = getTransportConfig(
+		providerModel{
+			ProxyURL:           types.StringValue("http://config-proxy.example.com:3128"),
+			CACertPEM:          types.StringValue("config-ca"),
+			InsecureSkipVerify: types.BoolValue(true),
+		},
+		env,
+	)
+	assert.False(t, diags.HasError())
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "Insecure TLS Connection", diags.Warnings()[0].Summary())
+	assert.Equal(t, transportConfig{
+		ProxyURL:           "http://config-proxy.example.com:3128",
+		CACertPEM:          "config-ca",
+		InsecureSkipVerify: true,
+	}, transport)
+}
@@ -18,10 +18,14 @@ type providerData struct {
 }
 
 // New returns configured provider data.
-func New(ctx context.Context, config api.ClientConfig) *providerData {
-	return &providerData{
-		API: api.New(ctx, config),
+func New(ctx context.Context, config api.ClientConfig) (*providerData, error) {
+	apiClient, err := api.New(ctx, config)
+	if err != nil {
+		return nil, err
 	}
+	return &providerData{
+		API: apiClient,
+	}, nil
 }
 
 type providerDataError struct {
@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -31,7 +32,7 @@ func (r *accountDiscoveryAWSResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_account_discovery_aws"
 }
 
-func (r *accountDiscoveryAWSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *accountDiscoveryAWSResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage an account discovery configuration for AWS.",
 		Attributes: map[string]schema.Attribute{
@@ -85,6 +86,14 @@ func (r *accountDiscoveryAWSResource) Schema(_ context.Context, _ resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -96,6 +105,14 @@ func (r *accountDiscoveryAWSResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := api.AccountDiscoveryAWSInput{
 		Name:          plan.Name.ValueString(),
 		Description:   plan.Description.ValueStringPointer(),
@@ -126,6 +143,14 @@ func (r *accountDiscoveryAWSResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	accountDiscovery, err := r.api.AccountDiscovery.Read(ctx, state.Name.ValueString())
 	if err != nil {
 		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
@@ -143,6 +168,14 @@ func (r *accountDiscoveryAWSResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	input := api.AccountDiscoveryAWSInput{
 		Name:          plan.Name.ValueString(),
 		Description:   plan.Description.ValueStringPointer(),
@@ -173,6 +206,14 @@ func (r *accountDiscoveryAWSResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if err := r.api.AccountDiscovery.Remove(ctx, state.ID.ValueString()); err != nil {
 		errors.AddDiagError(&resp.Diagnostics, err)
 	}
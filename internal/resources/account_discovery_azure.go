@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -31,7 +32,7 @@ func (r *accountDiscoveryAzureResource) Metadata(_ context.Context, req resource
 	resp.TypeName = req.ProviderTypeName + "_account_discovery_azure"
 }
 
-func (r *accountDiscoveryAzureResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *accountDiscoveryAzureResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manage an account discovery configuration for Azure.",
 		Attributes: map[string]schema.Attribute{
@@ -80,6 +81,14 @@ func (r *accountDiscoveryAzureResource) Schema(_ context.Context, _ resource.Sch
 				WriteOnly:   true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -91,6 +100,14 @@ func (r *accountDiscoveryAzureResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := api.AccountDiscoveryAzureInput{
 		Name:         plan.Name.ValueString(),
 		Description:  plan.Description.ValueStringPointer(),
@@ -121,6 +138,14 @@ func (r *accountDiscoveryAzureResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	accountDiscovery, err := r.api.AccountDiscovery.Read(ctx, state.Name.ValueString())
 	if err != nil {
 		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
@@ -140,6 +165,14 @@ func (r *accountDiscoveryAzureResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var clientID, clientSecret *string
 	// ID and secret must be set together, if not they're both left nil in the API call
 	if state.ClientID != plan.ClientID {
@@ -176,6 +209,14 @@ func (r *accountDiscoveryAzureResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if err := r.api.AccountDiscovery.Remove(ctx, state.ID.ValueString()); err != nil {
 		errors.AddDiagError(&resp.Diagnostics, err)
 	}
@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -32,7 +33,7 @@ func (r *accountDiscoveryGCPResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_account_discovery_gcp"
 }
 
-func (r *accountDiscoveryGCPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *accountDiscoveryGCPResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		DeprecationMessage: "GCP account discovery is now configured via stacklet_gcp_integration.",
 		Description:        "Manage an account discovery configuration for GCP.",
@@ -107,6 +108,14 @@ func (r *accountDiscoveryGCPResource) Schema(_ context.Context, _ resource.Schem
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -118,6 +127,14 @@ func (r *accountDiscoveryGCPResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input := api.AccountDiscoveryGCPInput{
 		Name:             plan.Name.ValueString(),
 		Description:      plan.Description.ValueStringPointer(),
@@ -149,6 +166,14 @@ func (r *accountDiscoveryGCPResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	accountDiscovery, err := r.api.AccountDiscovery.Read(ctx, state.Name.ValueString())
 	if err != nil {
 		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
@@ -168,6 +193,14 @@ func (r *accountDiscoveryGCPResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var credentialJSON *string
 	if state.CredentialJSONVersion != plan.CredentialJSONVersion {
 		credentialJSON = config.CredentialJSON.ValueStringPointer()
@@ -203,6 +236,14 @@ func (r *accountDiscoveryGCPResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if err := r.api.AccountDiscovery.Remove(ctx, state.ID.ValueString()); err != nil {
 		errors.AddDiagError(&resp.Diagnostics, err)
 	}
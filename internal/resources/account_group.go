@@ -30,6 +30,16 @@ type accountGroupResource struct {
 	apiResource
 }
 
+// accountGroupFieldAttributes maps account group input fields to resource
+// attributes.
+var accountGroupFieldAttributes = errors.FieldAttributes{
+	"name":          "name",
+	"description":   "description",
+	"provider":      "cloud_provider",
+	"dynamicFilter": "dynamic_filter",
+	"regions":       "regions",
+}
+
 func (r *accountGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_account_group"
 }
@@ -109,7 +119,7 @@ func (r *accountGroupResource) Create(ctx context.Context, req resource.CreateRe
 
 	account_group, err := r.api.AccountGroup.Create(ctx, input)
 	if err != nil {
-		errors.AddDiagError(&resp.Diagnostics, err)
+		errors.AddAttributeError(&resp.Diagnostics, err, accountGroupFieldAttributes)
 		return
 	}
 
@@ -151,7 +161,7 @@ func (r *accountGroupResource) Update(ctx context.Context, req resource.UpdateRe
 
 	account_group, err := r.api.AccountGroup.Update(ctx, input)
 	if err != nil {
-		errors.AddDiagError(&resp.Diagnostics, err)
+		errors.AddAttributeError(&resp.Diagnostics, err, accountGroupFieldAttributes)
 		return
 	}
 
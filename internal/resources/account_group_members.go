@@ -0,0 +1,175 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+	"github.com/stacklet/terraform-provider-stacklet/internal/typehelpers"
+)
+
+var (
+	_ resource.Resource                = &accountGroupMembersResource{}
+	_ resource.ResourceWithConfigure   = &accountGroupMembersResource{}
+	_ resource.ResourceWithImportState = &accountGroupMembersResource{}
+)
+
+type accountGroupMembersResource struct {
+	apiResource
+}
+
+func (r *accountGroupMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_group_members"
+}
+
+func (r *accountGroupMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manages the full set of accounts within an account group.
+
+This resource is authoritative: accounts in the group that are not listed are removed from it, and accounts added outside of Terraform are reported as changes. It must not be used together with stacklet_account_group_mapping resources for the same group.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource, same as the account group UUID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_uuid": schema.StringAttribute{
+				Description: "The UUID of the account group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_keys": schema.SetAttribute{
+				Description: "The keys of all the accounts in the group.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *accountGroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.AccountGroupMembersResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountKeys, diags := typehelpers.SetStrings(ctx, plan.AccountKeys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := r.setMembers(ctx, plan.GroupUUID.ValueString(), accountKeys)
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.Update(plan.GroupUUID.ValueString(), mappings)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *accountGroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.AccountGroupMembersResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := r.api.AccountGroupMapping.List(ctx, state.GroupUUID.ValueString())
+	if err != nil {
+		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(state.Update(state.GroupUUID.ValueString(), mappings)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *accountGroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.AccountGroupMembersResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountKeys, diags := typehelpers.SetStrings(ctx, plan.AccountKeys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := r.setMembers(ctx, plan.GroupUUID.ValueString(), accountKeys)
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.Update(plan.GroupUUID.ValueString(), mappings)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *accountGroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.AccountGroupMembersResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := r.api.AccountGroupMapping.List(ctx, state.GroupUUID.ValueString())
+	if err != nil {
+		if _, ok := err.(api.NotFound); !ok {
+			errors.AddDiagError(&resp.Diagnostics, err)
+		}
+		return
+	}
+	ids := make([]string, len(mappings))
+	for i, mapping := range mappings {
+		ids[i] = string(mapping.ID)
+	}
+	if err := r.api.AccountGroupMapping.DeleteMany(ctx, ids); err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+}
+
+func (r *accountGroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []string{"group_uuid"})
+}
+
+// setMembers updates the group membership to match the specified accounts,
+// returning the resulting mappings.
+func (r *accountGroupMembersResource) setMembers(ctx context.Context, groupUUID string, accountKeys []string) ([]api.AccountGroupMapping, error) {
+	current, err := r.api.AccountGroupMapping.List(ctx, groupUUID)
+	if err != nil {
+		return nil, err
+	}
+	currentIDs := make(map[string]string, len(current))
+	for _, mapping := range current {
+		currentIDs[mapping.AccountKey] = string(mapping.ID)
+	}
+
+	toAdd, toRemove := membersDiff(accountKeys, currentIDs)
+	if err := r.api.AccountGroupMapping.DeleteMany(ctx, toRemove); err != nil {
+		return nil, err
+	}
+	if err := r.api.AccountGroupMapping.CreateMany(ctx, groupUUID, toAdd); err != nil {
+		return nil, err
+	}
+
+	return r.api.AccountGroupMapping.List(ctx, groupUUID)
+}
@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,11 +32,38 @@ type bindingResource struct {
 	apiResource
 }
 
+// bindingFieldAttributes maps binding input fields to resource attributes.
+var bindingFieldAttributes = errors.FieldAttributes{
+	"name":                                   "name",
+	"description":                            "description",
+	"autoDeploy":                             "auto_deploy",
+	"schedule":                               "schedule",
+	"accountGroupUUID":                       "account_group_uuid",
+	"policyCollectionUUID":                   "policy_collection_uuid",
+	"executionConfig":                        "",
+	"executionConfig.dryRun":                 "dry_run",
+	"executionConfig.dryRun.default":         "",
+	"executionConfig.resourceLimits":         "",
+	"executionConfig.resourceLimits.default": "resource_limits",
+	"executionConfig.resourceLimits.default.maxCount":                    "max_count",
+	"executionConfig.resourceLimits.default.maxPercentage":               "max_percentage",
+	"executionConfig.resourceLimits.default.requiresBoth":                "requires_both",
+	"executionConfig.resourceLimits.policyOverrides":                     "policy_resource_limit",
+	"executionConfig.resourceLimits.policyOverrides.policyName":          "policy_name",
+	"executionConfig.resourceLimits.policyOverrides.limit":               "",
+	"executionConfig.resourceLimits.policyOverrides.limit.maxCount":      "max_count",
+	"executionConfig.resourceLimits.policyOverrides.limit.maxPercentage": "max_percentage",
+	"executionConfig.resourceLimits.policyOverrides.limit.requiresBoth":  "requires_both",
+	"executionConfig.securityContext":                                    "security_context_wo",
+	"executionConfig.securityContext.default":                            "",
+	"executionConfig.variables":                                          "variables",
+}
+
 func (r *bindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_binding"
 }
 
-func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *bindingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a binding between an account group and a policy collection.",
 		Attributes: map[string]schema.Attribute{
@@ -168,6 +196,12 @@ func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					schemavalidate.UniqueStringAttribute("policy_name"),
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -180,6 +214,14 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	executionConfig, diags := r.getExecutionConfig(ctx, plan, config.SecurityContextWO.ValueStringPointer())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -199,7 +241,7 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 
 	binding, err := r.api.Binding.Create(ctx, input)
 	if err != nil {
-		errors.AddDiagError(&resp.Diagnostics, err)
+		errors.AddAttributeError(&resp.Diagnostics, err, bindingFieldAttributes)
 		return
 	}
 
@@ -214,6 +256,14 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	binding, err := r.api.Binding.Read(ctx, state.UUID.ValueString(), "")
 	if err != nil {
 		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
@@ -233,6 +283,14 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var securityContextString *string
 	if state.SecurityContextWOVersion == plan.SecurityContextWOVersion {
 		// if no change happened, send the value we got from the API as a
@@ -259,7 +317,7 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	binding, err := r.api.Binding.Update(ctx, input)
 	if err != nil {
-		errors.AddDiagError(&resp.Diagnostics, err)
+		errors.AddAttributeError(&resp.Diagnostics, err, bindingFieldAttributes)
 		return
 	}
 
@@ -274,6 +332,14 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if err := r.api.Binding.Delete(ctx, state.UUID.ValueString()); err != nil {
 		errors.AddDiagError(&resp.Diagnostics, err)
 		return
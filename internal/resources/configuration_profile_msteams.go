@@ -5,6 +5,7 @@ package resources
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -38,7 +39,7 @@ func (r *configurationProfileMSTeamsResource) Metadata(_ context.Context, req re
 	resp.TypeName = req.ProviderTypeName + "_configuration_profile_msteams"
 }
 
-func (r *configurationProfileMSTeamsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *configurationProfileMSTeamsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `Manage the Microsoft Teams configuration profile.
 
@@ -246,6 +247,12 @@ The profile is global, adding multiple resources of this kind will cause them to
 					},
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -257,6 +264,14 @@ func (r *configurationProfileMSTeamsResource) Create(ctx context.Context, req re
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	input, diags := r.buildInput(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -280,6 +295,14 @@ func (r *configurationProfileMSTeamsResource) Read(ctx context.Context, req reso
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	profileConfig, err := r.api.ConfigurationProfile.ReadMSTeams(ctx)
 	if err != nil {
 		errors.AddDiagError(&resp.Diagnostics, err)
@@ -297,6 +320,14 @@ func (r *configurationProfileMSTeamsResource) Update(ctx context.Context, req re
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	input, diags := r.buildInput(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -320,6 +351,14 @@ func (r *configurationProfileMSTeamsResource) Delete(ctx context.Context, req re
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if err := r.api.ConfigurationProfile.DeleteMSTeams(ctx); err != nil {
 		errors.AddDiagError(&resp.Diagnostics, err)
 		return
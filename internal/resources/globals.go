@@ -3,8 +3,14 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // objectAsOptions are the options used by ObjectValue.As calls.
 var objectAsOptions = basetypes.ObjectAsOptions{}
+
+// defaultTimeout is the default timeout for operations on resources
+// supporting a timeouts configuration.
+const defaultTimeout = 20 * time.Minute
@@ -0,0 +1,163 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package resources
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+)
+
+// membersDiff returns the changes needed for authoritative membership
+// resources to go from the current members to the desired ones.
+//
+// Current members are mapped by their key to the ID used to remove them. The
+// returned keys to add and IDs to remove are sorted, to make requests
+// deterministic.
+func membersDiff[K cmp.Ordered, ID cmp.Ordered](desired []K, current map[K]ID) (toAdd []K, toRemove []ID) {
+	toAdd = make([]K, 0)
+	toRemove = make([]ID, 0)
+	for _, key := range desired {
+		if _, ok := current[key]; !ok && !slices.Contains(toAdd, key) {
+			toAdd = append(toAdd, key)
+		}
+	}
+	for key, id := range current {
+		if !slices.Contains(desired, key) {
+			toRemove = append(toRemove, id)
+		}
+	}
+	slices.Sort(toAdd)
+	slices.Sort(toRemove)
+	return toAdd, toRemove
+}
+
+// roleGrantsDiff returns the role grants and revocations needed to go from the
+// current role assignments to the desired ones.
+func roleGrantsDiff(desired []api.RoleGrant, current []api.RoleAssignment) (grant []api.RoleGrant, revoke []api.RoleGrant) {
+	byKey := make(map[string]api.RoleGrant, len(desired)+len(current))
+	desiredKeys := make([]string, len(desired))
+	for i, g := range desired {
+		key := roleGrantKey(g)
+		byKey[key] = g
+		desiredKeys[i] = key
+	}
+	currentKeys := make(map[string]string, len(current))
+	for _, assignment := range current {
+		g := roleGrant(assignment)
+		key := roleGrantKey(g)
+		byKey[key] = g
+		currentKeys[key] = key
+	}
+
+	toAdd, toRemove := membersDiff(desiredKeys, currentKeys)
+	grant = make([]api.RoleGrant, len(toAdd))
+	for i, key := range toAdd {
+		grant[i] = byKey[key]
+	}
+	revoke = make([]api.RoleGrant, len(toRemove))
+	for i, key := range toRemove {
+		revoke[i] = byKey[key]
+	}
+	return grant, revoke
+}
+
+// callerPrincipals returns which of the provided principals the caller is
+// granted roles through: its own user principal, and those of user groups it's
+// a member of.
+//
+// The API doesn't expose SSO group membership, so SSO group principals are
+// never returned.
+func callerPrincipals(ctx context.Context, a *api.API, caller *api.Caller, principals []string) ([]string, error) {
+	own := make([]string, 0)
+	others := make([]string, 0)
+	for _, principal := range principals {
+		if principal == caller.User.RoleAssignmentPrincipal {
+			own = append(own, principal)
+		} else {
+			others = append(others, principal)
+		}
+	}
+	if len(others) == 0 {
+		return own, nil
+	}
+
+	groups, err := a.UserGroup.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if !slices.Contains(others, group.RoleAssignmentPrincipal) {
+			continue
+		}
+		members, err := a.UserGroupMember.List(ctx, group.UUID)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(members, func(m api.UserGroupMember) bool { return m.UserKey == caller.User.Key }) {
+			own = append(own, group.RoleAssignmentPrincipal)
+		}
+	}
+	return own, nil
+}
+
+// callerRoleGrants splits role grants between the ones granting access to the
+// caller and the others.
+func callerRoleGrants(ctx context.Context, a *api.API, grants []api.RoleGrant) (own []api.RoleGrant, others []api.RoleGrant, err error) {
+	caller, err := a.User.Caller(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	principals := make([]string, 0, len(grants))
+	for _, g := range grants {
+		principals = append(principals, g.Principal)
+	}
+	principals, err = callerPrincipals(ctx, a, caller, principals)
+	if err != nil {
+		return nil, nil, err
+	}
+	own, others = ownRoleGrants(grants, principals)
+	return own, others, nil
+}
+
+// ownRoleGrants splits role grants between the ones for any of the caller's
+// principals and the others.
+func ownRoleGrants(grants []api.RoleGrant, callerPrincipals []string) (own []api.RoleGrant, others []api.RoleGrant) {
+	own = make([]api.RoleGrant, 0)
+	others = make([]api.RoleGrant, 0)
+	for _, g := range grants {
+		if slices.Contains(callerPrincipals, g.Principal) {
+			own = append(own, g)
+		} else {
+			others = append(others, g)
+		}
+	}
+	return own, others
+}
+
+// roleGrantsDescription returns a human-readable list of role grants.
+func roleGrantsDescription(grants []api.RoleGrant) string {
+	descriptions := make([]string, len(grants))
+	for i, g := range grants {
+		descriptions[i] = fmt.Sprintf("%s for %s on %s", g.RoleName, g.Principal, g.Target)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// roleGrant returns the role grant for an existing role assignment.
+func roleGrant(assignment api.RoleAssignment) api.RoleGrant {
+	return api.RoleGrant{
+		RoleName:  assignment.Role.Name,
+		Principal: assignment.GetPrincipal(),
+		Target:    assignment.GetTarget(),
+	}
+}
+
+// roleGrantKey returns a key identifying a role grant.
+func roleGrantKey(grant api.RoleGrant) string {
+	return grant.RoleName + "\x00" + grant.Principal + "\x00" + grant.Target
+}
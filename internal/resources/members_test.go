@@ -0,0 +1,101 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+)
+
+func TestMembersDiff(t *testing.T) {
+	toAdd, toRemove := membersDiff(
+		[]string{"c", "a", "b", "c"},
+		map[string]string{"a": "id-a", "d": "id-d", "e": "id-e"},
+	)
+	assert.Equal(t, []string{"b", "c"}, toAdd)
+	assert.Equal(t, []string{"id-d", "id-e"}, toRemove)
+}
+
+func TestMembersDiff_NoChanges(t *testing.T) {
+	toAdd, toRemove := membersDiff([]string{"a"}, map[string]string{"a": "id-a"})
+	assert.Empty(t, toAdd)
+	assert.Empty(t, toRemove)
+}
+
+func TestMembersDiff_RemoveAll(t *testing.T) {
+	toAdd, toRemove := membersDiff(nil, map[string]string{"a": "id-a", "b": "id-b"})
+	assert.Empty(t, toAdd)
+	assert.Equal(t, []string{"id-a", "id-b"}, toRemove)
+}
+
+func TestMembersDiff_Int64Keys(t *testing.T) {
+	toAdd, toRemove := membersDiff([]int64{3, 1}, map[int64]int64{1: 1, 2: 2})
+	assert.Equal(t, []int64{3}, toAdd)
+	assert.Equal(t, []int64{2}, toRemove)
+}
+
+func TestRoleGrantsDiff(t *testing.T) {
+	assignment := func(roleName, principal, target string) api.RoleAssignment {
+		var a api.RoleAssignment
+		a.Role.Name = roleName
+		a.Principal.RoleAssignmentPrincipal = principal
+		a.Target.RoleAssignmentTarget = target
+		return a
+	}
+
+	grant, revoke := roleGrantsDiff(
+		[]api.RoleGrant{
+			{RoleName: "viewer", Principal: "user:1", Target: "system:all"},
+			{RoleName: "editor", Principal: "user:1", Target: "account-group:1"},
+		},
+		[]api.RoleAssignment{
+			assignment("viewer", "user:1", "system:all"),
+			assignment("viewer", "user:1", "account-group:1"),
+		},
+	)
+	assert.Equal(t, []api.RoleGrant{{RoleName: "editor", Principal: "user:1", Target: "account-group:1"}}, grant)
+	assert.Equal(t, []api.RoleGrant{{RoleName: "viewer", Principal: "user:1", Target: "account-group:1"}}, revoke)
+}
+
+func TestOwnRoleGrants(t *testing.T) {
+	own, others := ownRoleGrants(
+		[]api.RoleGrant{
+			{RoleName: "owner", Principal: "user:1", Target: "system:all"},
+			{RoleName: "viewer", Principal: "user:2", Target: "system:all"},
+			{RoleName: "editor", Principal: "user-group:1", Target: "account-group:1"},
+		},
+		[]string{"user:1", "user-group:1"},
+	)
+	assert.Equal(
+		t,
+		[]api.RoleGrant{
+			{RoleName: "owner", Principal: "user:1", Target: "system:all"},
+			{RoleName: "editor", Principal: "user-group:1", Target: "account-group:1"},
+		},
+		own,
+	)
+	assert.Equal(t, []api.RoleGrant{{RoleName: "viewer", Principal: "user:2", Target: "system:all"}}, others)
+}
+
+func TestOwnRoleGrants_None(t *testing.T) {
+	own, others := ownRoleGrants(
+		[]api.RoleGrant{{RoleName: "viewer", Principal: "user:2", Target: "system:all"}},
+		[]string{"user:1"},
+	)
+	assert.Empty(t, own)
+	assert.Len(t, others, 1)
+}
+
+func TestRoleGrantsDescription(t *testing.T) {
+	assert.Equal(
+		t,
+		"owner for user:1 on system:all, viewer for user-group:2 on account-group:1",
+		roleGrantsDescription([]api.RoleGrant{
+			{RoleName: "owner", Principal: "user:1", Target: "system:all"},
+			{RoleName: "viewer", Principal: "user-group:2", Target: "account-group:1"},
+		}),
+	)
+}
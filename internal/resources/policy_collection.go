@@ -33,6 +33,20 @@ type policyCollectionResource struct {
 	apiResource
 }
 
+// policyCollectionFieldAttributes maps policy collection input fields to
+// resource attributes.
+var policyCollectionFieldAttributes = errors.FieldAttributes{
+	"name":                             "name",
+	"description":                      "description",
+	"provider":                         "cloud_provider",
+	"autoUpdate":                       "auto_update",
+	"repositoryUUID":                   "dynamic_config.repository_uuid",
+	"repositoryView":                   "dynamic_config",
+	"repositoryView.branchName":        "branch_name",
+	"repositoryView.policyDirectories": "policy_directories",
+	"repositoryView.policyFileSuffix":  "policy_file_suffixes",
+}
+
 func (r *policyCollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_policy_collection"
 }
@@ -172,7 +186,7 @@ func (r *policyCollectionResource) Create(ctx context.Context, req resource.Crea
 	}
 	policyCollection, err := r.api.PolicyCollection.Create(ctx, input)
 	if err != nil {
-		errors.AddDiagError(&resp.Diagnostics, err)
+		errors.AddAttributeError(&resp.Diagnostics, err, policyCollectionFieldAttributes)
 		return
 	}
 
@@ -222,7 +236,7 @@ func (r *policyCollectionResource) Update(ctx context.Context, req resource.Upda
 
 	policyCollection, err := r.api.PolicyCollection.Update(ctx, input)
 	if err != nil {
-		errors.AddDiagError(&resp.Diagnostics, err)
+		errors.AddAttributeError(&resp.Diagnostics, err, policyCollectionFieldAttributes)
 		return
 	}
 
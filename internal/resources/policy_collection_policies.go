@@ -0,0 +1,275 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+	"github.com/stacklet/terraform-provider-stacklet/internal/schemavalidate"
+)
+
+var (
+	_ resource.Resource                = &policyCollectionPoliciesResource{}
+	_ resource.ResourceWithConfigure   = &policyCollectionPoliciesResource{}
+	_ resource.ResourceWithImportState = &policyCollectionPoliciesResource{}
+)
+
+type policyCollectionPoliciesResource struct {
+	apiResource
+}
+
+func (r *policyCollectionPoliciesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_collection_policies"
+}
+
+func (r *policyCollectionPoliciesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manages the full set of policies within a policy collection.
+
+This resource is authoritative: policies in the collection that are not listed are removed from it, and policies added outside of Terraform are reported as changes. It must not be used together with stacklet_policy_collection_mapping resources for the same collection.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource, same as the policy collection UUID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collection_uuid": schema.StringAttribute{
+				Description: "The UUID of the policy collection.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policies": schema.SetNestedAttribute{
+				Description: "All the policies in the collection.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_uuid": schema.StringAttribute{
+							Description: "The UUID of the policy.",
+							Required:    true,
+						},
+						"policy_version": schema.Int32Attribute{
+							Description: "The version of the policy. If not specified, the latest version is used, and the collection is updated when a new version is available.",
+							Optional:    true,
+						},
+					},
+				},
+				Validators: []validator.Set{
+					schemavalidate.UniqueStringSetAttribute("policy_uuid"),
+				},
+			},
+		},
+	}
+}
+
+func (r *policyCollectionPoliciesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.PolicyCollectionPoliciesResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPolicies(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *policyCollectionPoliciesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PolicyCollectionPoliciesResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	collectionUUID := state.CollectionUUID.ValueString()
+	mappings, err := r.api.PolicyCollectionMapping.List(ctx, collectionUUID)
+	if err != nil {
+		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
+		return
+	}
+
+	// Policies tracking the latest version are only reported as changed if
+	// the collection doesn't include the latest version.
+	latestVersions := make(map[string]int)
+	if !state.Policies.IsNull() {
+		entries, diags := state.Entries(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		latestVersions, err = r.latestVersions(ctx, latestPolicyUUIDs(entries))
+		if err != nil {
+			errors.AddDiagError(&resp.Diagnostics, err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(state.Update(ctx, collectionUUID, mappings, latestVersions)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *policyCollectionPoliciesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.PolicyCollectionPoliciesResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPolicies(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *policyCollectionPoliciesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PolicyCollectionPoliciesResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappings, err := r.api.PolicyCollectionMapping.List(ctx, state.CollectionUUID.ValueString())
+	if err != nil {
+		if _, ok := err.(api.NotFound); !ok {
+			errors.AddDiagError(&resp.Diagnostics, err)
+		}
+		return
+	}
+	ids := make([]string, len(mappings))
+	for i, mapping := range mappings {
+		ids[i] = string(mapping.ID)
+	}
+	if err := r.api.PolicyCollectionMapping.DeleteMany(ctx, ids); err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+}
+
+func (r *policyCollectionPoliciesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []string{"collection_uuid"})
+}
+
+// setPolicies updates the collection policies to match the plan, updating it
+// with the resulting policies.
+func (r *policyCollectionPoliciesResource) setPolicies(ctx context.Context, plan *models.PolicyCollectionPoliciesResource) diag.Diagnostics {
+	entries, diags := plan.Entries(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	collectionUUID := plan.CollectionUUID.ValueString()
+	current, err := r.api.PolicyCollectionMapping.List(ctx, collectionUUID)
+	if err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+	currentIDs := make(map[string]string, len(current))
+	currentVersions := make(map[string]int, len(current))
+	for _, mapping := range current {
+		currentIDs[mapping.Policy.UUID] = string(mapping.ID)
+		currentVersions[mapping.Policy.UUID] = mapping.Policy.Version
+	}
+
+	latestVersions, err := r.latestVersions(ctx, latestPolicyUUIDs(entries))
+	if err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+	policyUUIDs := make([]string, len(entries))
+	versions := make(map[string]int, len(entries))
+	for i, entry := range entries {
+		policyUUID := entry.PolicyUUID.ValueString()
+		policyUUIDs[i] = policyUUID
+		if entry.PolicyVersion.IsNull() {
+			version, ok := latestVersions[policyUUID]
+			if !ok {
+				errors.AddDiagError(&diags, api.NotFound{Message: fmt.Sprintf("Policy %s not found", policyUUID)})
+				return diags
+			}
+			versions[policyUUID] = version
+		} else {
+			versions[policyUUID] = int(entry.PolicyVersion.ValueInt32())
+		}
+	}
+
+	toAdd, toRemove := membersDiff(policyUUIDs, currentIDs)
+	// policies already in the collection with a different version are updated
+	for policyUUID, version := range currentVersions {
+		if desired, ok := versions[policyUUID]; ok && desired != version {
+			toAdd = append(toAdd, policyUUID)
+		}
+	}
+	slices.Sort(toAdd)
+	inputs := make([]api.PolicyCollectionMappingInput, len(toAdd))
+	for i, policyUUID := range toAdd {
+		inputs[i] = api.PolicyCollectionMappingInput{
+			CollectionUUID: collectionUUID,
+			PolicyUUID:     policyUUID,
+			PolicyVersion:  versions[policyUUID],
+		}
+	}
+
+	if err := r.api.PolicyCollectionMapping.DeleteMany(ctx, toRemove); err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+	if err := r.api.PolicyCollectionMapping.UpsertMany(ctx, inputs); err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+
+	mappings, err := r.api.PolicyCollectionMapping.List(ctx, collectionUUID)
+	if err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+	diags.Append(plan.Update(ctx, collectionUUID, mappings, latestVersions)...)
+	return diags
+}
+
+// latestVersions returns the latest version of the specified policies,
+// listing them together rather than reading each one. Policies that don't
+// exist are not included.
+func (r *policyCollectionPoliciesResource) latestVersions(ctx context.Context, policyUUIDs []string) (map[string]int, error) {
+	versions := make(map[string]int, len(policyUUIDs))
+	if len(policyUUIDs) == 0 {
+		return versions, nil
+	}
+	policies, err := r.api.Policy.List(ctx, api.PoliciesFilter{UUIDs: policyUUIDs})
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		versions[policy.UUID] = policy.Version
+	}
+	return versions, nil
+}
+
+// latestPolicyUUIDs returns the UUIDs of policies tracking the latest version.
+func latestPolicyUUIDs(entries []models.PolicyCollectionPoliciesEntry) []string {
+	policyUUIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.PolicyVersion.IsNull() {
+			policyUUIDs = append(policyUUIDs, entry.PolicyUUID.ValueString())
+		}
+	}
+	return policyUUIDs
+}
@@ -35,6 +35,26 @@ type reportGroupResource struct {
 	apiResource
 }
 
+// reportGroupFieldAttributes maps report group input fields to resource
+// attributes.
+var reportGroupFieldAttributes = errors.FieldAttributes{
+	"reportGroups":                         "",
+	"reportGroups.name":                    "name",
+	"reportGroups.enabled":                 "enabled",
+	"reportGroups.bindings":                "bindings",
+	"reportGroups.source":                  "source",
+	"reportGroups.schedule":                "schedule",
+	"reportGroups.groupBy":                 "group_by",
+	"reportGroups.useMessageSettings":      "use_message_settings",
+	"reportGroups.emailSettings":           "email_delivery_settings",
+	"reportGroups.emailSettings.fromEmail": "from",
+	"reportGroups.slackSettings":           "slack_delivery_settings",
+	"reportGroups.msteamsSettings":         "msteams_delivery_settings",
+	"reportGroups.serviceNowSettings":      "servicenow_delivery_settings",
+	"reportGroups.jiraSettings":            "jira_delivery_settings",
+	"reportGroups.symphonySettings":        "symphony_delivery_settings",
+}
+
 func (r *reportGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_report_group"
 }
@@ -536,7 +556,7 @@ func (r *reportGroupResource) Create(ctx context.Context, req resource.CreateReq
 	}
 	reportGroup, err := r.api.ReportGroup.Upsert(ctx, input)
 	if err != nil {
-		errors.AddDiagError(&resp.Diagnostics, err)
+		errors.AddAttributeError(&resp.Diagnostics, err, reportGroupFieldAttributes)
 		return
 	}
 
@@ -604,7 +624,7 @@ func (r *reportGroupResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	reportGroup, err := r.api.ReportGroup.Upsert(ctx, input)
 	if err != nil {
-		errors.AddDiagError(&resp.Diagnostics, err)
+		errors.AddAttributeError(&resp.Diagnostics, err, reportGroupFieldAttributes)
 		return
 	}
 
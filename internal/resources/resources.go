@@ -29,6 +29,7 @@ var Resources = resources{
 		newFactory(&accountDiscoveryAzureResource{}),
 		newFactory(&accountDiscoveryGCPResource{}),
 		newFactory(&accountGroupMappingResource{}),
+		newFactory(&accountGroupMembersResource{}),
 		newFactory(&accountGroupResource{}),
 		newFactory(&accountResource{}),
 		newFactory(&bindingResource{}),
@@ -43,11 +44,14 @@ var Resources = resources{
 		newFactory(&gcpIntegrationResource{}),
 		newFactory(&notificationTemplateResource{}),
 		newFactory(&policyCollectionMappingResource{}),
+		newFactory(&policyCollectionPoliciesResource{}),
 		newFactory(&policyCollectionResource{}),
 		newFactory(&reportGroupResource{}),
 		newFactory(&repositoryResource{}),
 		newFactory(&roleAssignmentResource{}),
+		newFactory(&roleAssignmentsResource{}),
 		newFactory(&samlProviderResource{}),
+		newFactory(&ssoGroupGrantsResource{}),
 		newFactory(&ssoGroupResource{}),
 		newFactory(&userGroupResource{}),
 		newFactory(&userResource{}),
@@ -0,0 +1,207 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+)
+
+var (
+	_ resource.Resource                = &roleAssignmentsResource{}
+	_ resource.ResourceWithConfigure   = &roleAssignmentsResource{}
+	_ resource.ResourceWithImportState = &roleAssignmentsResource{}
+)
+
+type roleAssignmentsResource struct {
+	apiResource
+}
+
+func (r *roleAssignmentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignments"
+}
+
+func (r *roleAssignmentsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manages the full set of role assignments on a target (system, account group, policy collection, or repository).
+
+This resource is authoritative: role assignments on the target that are not listed are revoked, and assignments granted outside of Terraform are reported as changes. It must not be used together with stacklet_role_assignment resources for the same target. Revoking role assignments granting access to the caller, directly or through a user group it's a member of, is refused, and they are kept when the resource is destroyed, to avoid lockouts. SSO group membership isn't exposed by the API, so role assignments for SSO groups are not protected.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource, same as the target.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"target": schema.StringAttribute{
+				Description: "An opaque target identifier. Use the 'role_assignment_target' computed attribute from account group, policy collection, or repository resources.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assignments": schema.SetNestedAttribute{
+				Description: "All the role assignments on the target.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_name": schema.StringAttribute{
+							Description: "The name of the role to assign. Use the stacklet_role data source to find available roles.",
+							Required:    true,
+						},
+						"principal": schema.StringAttribute{
+							Description: "An opaque principal identifier. Use the 'role_assignment_principal' computed attribute from user or user group resources.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *roleAssignmentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.RoleAssignmentsResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setAssignments(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleAssignmentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.RoleAssignmentsResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := state.Target.ValueString()
+	assignments, err := r.api.RoleAssignment.List(ctx, target)
+	if err != nil {
+		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(state.Update(ctx, target, assignments)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *roleAssignmentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.RoleAssignmentsResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setAssignments(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *roleAssignmentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.RoleAssignmentsResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := state.Target.ValueString()
+	assignments, err := r.api.RoleAssignment.List(ctx, target)
+	if err != nil {
+		if _, ok := err.(api.NotFound); !ok {
+			errors.AddDiagError(&resp.Diagnostics, err)
+		}
+		return
+	}
+	revoke := make([]api.RoleGrant, len(assignments))
+	for i, assignment := range assignments {
+		revoke[i] = roleGrant(assignment)
+	}
+	if len(revoke) == 0 {
+		return
+	}
+	// Keep the caller's own assignments, so that it doesn't lose access
+	own, revoke, err := callerRoleGrants(ctx, r.api, revoke)
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+	if err := r.api.RoleAssignment.Update(ctx, nil, revoke); err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+	if len(own) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Own Role Assignments Kept",
+			fmt.Sprintf("Role assignments granting access to the caller were not revoked: %s. Revoke them with a different identity if they're no longer needed.", roleGrantsDescription(own)),
+		)
+	}
+}
+
+func (r *roleAssignmentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []string{"target"})
+}
+
+// setAssignments updates the role assignments on the target to match the
+// plan.
+//
+// Revoking role assignments granting access to the caller is refused, as it
+// could lock it out.
+func (r *roleAssignmentsResource) setAssignments(ctx context.Context, plan *models.RoleAssignmentsResource) diag.Diagnostics {
+	grants, diags := plan.Grants(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	target := plan.Target.ValueString()
+	current, err := r.api.RoleAssignment.List(ctx, target)
+	if err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+
+	grant, revoke := roleGrantsDiff(grants, current)
+	if len(revoke) > 0 {
+		own, _, err := callerRoleGrants(ctx, r.api, revoke)
+		if err != nil {
+			errors.AddDiagError(&diags, err)
+			return diags
+		}
+		if len(own) > 0 {
+			diags.AddAttributeError(
+				path.Root("assignments"),
+				"Revoking Own Role Assignments",
+				fmt.Sprintf("The change would revoke role assignments granting access to the caller: %s. Revoke them with a different identity.", roleGrantsDescription(own)),
+			)
+			return diags
+		}
+	}
+	if err := r.api.RoleAssignment.Update(ctx, grant, revoke); err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+
+	plan.ID = types.StringValue(target)
+	return diags
+}
@@ -0,0 +1,295 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/stacklet/terraform-provider-stacklet/internal/api"
+	"github.com/stacklet/terraform-provider-stacklet/internal/errors"
+	"github.com/stacklet/terraform-provider-stacklet/internal/models"
+	"github.com/stacklet/terraform-provider-stacklet/internal/schemadefault"
+)
+
+var (
+	_ resource.Resource                = &ssoGroupGrantsResource{}
+	_ resource.ResourceWithConfigure   = &ssoGroupGrantsResource{}
+	_ resource.ResourceWithImportState = &ssoGroupGrantsResource{}
+)
+
+type ssoGroupGrantsResource struct {
+	apiResource
+}
+
+func (r *ssoGroupGrantsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_group_grants"
+}
+
+func (r *ssoGroupGrantsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Manages the role assignments an SSO group grants to its members, on any target.
+
+This resource is authoritative: role assignments of the SSO group that are not listed are revoked, and those granted outside of Terraform are reported as changes. It must not be used together with stacklet_role_assignment resources for the SSO group principal, and it conflicts with a stacklet_role_assignments resource for any of the targets, as each would revoke the assignments managed by the other.
+
+SSO group membership isn't exposed by the API, so when the caller is an SSO user it might be granted access through the SSO group. In that case, revoking a role the caller doesn't also have on the same target, directly or through a user group it's a member of, is refused, and such role assignments are kept when the resource is destroyed, to avoid lockouts.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource, same as the SSO group name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sso_group": schema.StringAttribute{
+				Description: "The name of the SSO group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_assignments": schema.SetNestedAttribute{
+				Description: "All the role assignments for the SSO group.",
+				Optional:    true,
+				Computed:    true,
+				Default: schemadefault.EmptySetDefault(
+					types.ObjectType{AttrTypes: models.SSOGroupGrantsRoleAssignment{}.AttributeTypes()},
+				),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_name": schema.StringAttribute{
+							Description: "The name of the role to assign. Use the stacklet_role data source to find available roles.",
+							Required:    true,
+						},
+						"target": schema.StringAttribute{
+							Description: "An opaque target identifier. Use the 'role_assignment_target' computed attribute from account group, policy collection, or repository resources.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ssoGroupGrantsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.SSOGroupGrantsResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setGrants(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ssoGroupGrantsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.SSOGroupGrantsResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.SSOGroup.ValueString()
+	group, err := r.api.SSOGroup.Read(ctx, name)
+	if err != nil {
+		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
+		return
+	}
+	assignments, err := r.api.RoleAssignment.ListByPrincipal(ctx, group.RoleAssignmentPrincipal)
+	if err != nil {
+		handleAPIError(ctx, &resp.State, &resp.Diagnostics, err)
+		return
+	}
+
+	resp.Diagnostics.Append(state.Update(ctx, name, assignments)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ssoGroupGrantsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.SSOGroupGrantsResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setGrants(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ssoGroupGrantsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.SSOGroupGrantsResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.api.SSOGroup.Read(ctx, state.SSOGroup.ValueString())
+	if err != nil {
+		if _, ok := err.(api.NotFound); !ok {
+			errors.AddDiagError(&resp.Diagnostics, err)
+		}
+		return
+	}
+	assignments, err := r.api.RoleAssignment.ListByPrincipal(ctx, group.RoleAssignmentPrincipal)
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+	revoke := make([]api.RoleGrant, len(assignments))
+	for i, assignment := range assignments {
+		revoke[i] = roleGrant(assignment)
+	}
+	if len(revoke) == 0 {
+		return
+	}
+	// Keep the assignments the caller might get access from, so that it
+	// doesn't lose it
+	own, revoke, err := r.callerRoleGrants(ctx, revoke)
+	if err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+	if err := r.api.RoleAssignment.Update(ctx, nil, revoke); err != nil {
+		errors.AddDiagError(&resp.Diagnostics, err)
+		return
+	}
+	if len(own) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Own SSO Group Role Assignments Kept",
+			fmt.Sprintf("Role assignments that might grant access to the caller through SSO group %s were not revoked: %s. Revoke them with a different identity if they're no longer needed.", group.Name, roleGrantsDescription(own)),
+		)
+	}
+}
+
+func (r *ssoGroupGrantsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp, []string{"sso_group"})
+}
+
+// callerRoleGrants splits role grants for the SSO group between the ones that
+// might grant access to the caller and the others.
+//
+// The API doesn't expose SSO group membership, so an SSO user might be
+// granted roles through any SSO group. Grants are only considered the
+// caller's if it doesn't have the same role on the target through its own
+// user or user groups.
+func (r *ssoGroupGrantsResource) callerRoleGrants(ctx context.Context, grants []api.RoleGrant) (own []api.RoleGrant, others []api.RoleGrant, err error) {
+	caller, err := r.api.User.Caller(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !caller.User.SSOUser {
+		return []api.RoleGrant{}, grants, nil
+	}
+
+	targets := make([]string, 0)
+	for _, grant := range grants {
+		if !slices.Contains(targets, grant.Target) {
+			targets = append(targets, grant.Target)
+		}
+	}
+	// assignments of the same roles on the same targets to other principals
+	held := make([]api.RoleGrant, 0)
+	for _, target := range targets {
+		assignments, err := r.api.RoleAssignment.List(ctx, target)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, assignment := range assignments {
+			g := roleGrant(assignment)
+			if !slices.ContainsFunc(grants, func(grant api.RoleGrant) bool {
+				return grant.Principal != g.Principal && grant.RoleName == g.RoleName && grant.Target == g.Target
+			}) {
+				continue
+			}
+			held = append(held, g)
+		}
+	}
+	principals := make([]string, len(held))
+	for i, g := range held {
+		principals[i] = g.Principal
+	}
+	principals, err = callerPrincipals(ctx, r.api, caller, principals)
+	if err != nil {
+		return nil, nil, err
+	}
+	heldOwn, _ := ownRoleGrants(held, principals)
+
+	own = make([]api.RoleGrant, 0)
+	others = make([]api.RoleGrant, 0)
+	for _, grant := range grants {
+		if slices.ContainsFunc(heldOwn, func(g api.RoleGrant) bool {
+			return g.RoleName == grant.RoleName && g.Target == grant.Target
+		}) {
+			others = append(others, grant)
+		} else {
+			own = append(own, grant)
+		}
+	}
+	return own, others, nil
+}
+
+// setGrants updates the SSO group role assignments to match the plan.
+//
+// Revoking role assignments that might grant access to the caller is refused,
+// as it could lock it out.
+func (r *ssoGroupGrantsResource) setGrants(ctx context.Context, plan *models.SSOGroupGrantsResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	name := plan.SSOGroup.ValueString()
+	group, err := r.api.SSOGroup.Read(ctx, name)
+	if err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+	grants, d := plan.Grants(ctx, group.RoleAssignmentPrincipal)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.api.RoleAssignment.ListByPrincipal(ctx, group.RoleAssignmentPrincipal)
+	if err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+	grant, revoke := roleGrantsDiff(grants, current)
+	if len(revoke) > 0 {
+		own, _, err := r.callerRoleGrants(ctx, revoke)
+		if err != nil {
+			errors.AddDiagError(&diags, err)
+			return diags
+		}
+		if len(own) > 0 {
+			diags.AddAttributeError(
+				path.Root("role_assignments"),
+				"Revoking Own SSO Group Role Assignments",
+				fmt.Sprintf("The caller is an SSO user and might be a member of SSO group %s, the change would revoke role assignments it doesn't otherwise have: %s. Revoke them with a different identity.", name, roleGrantsDescription(own)),
+			)
+			return diags
+		}
+	}
+
+	if err := r.api.RoleAssignment.Update(ctx, grant, revoke); err != nil {
+		errors.AddDiagError(&diags, err)
+		return diags
+	}
+
+	plan.ID = types.StringValue(name)
+	return diags
+}
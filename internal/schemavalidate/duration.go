@@ -0,0 +1,46 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package schemavalidate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Duration returns a validator that checks that the value is a valid,
+// non-negative duration string (e.g. "30s", "1m30s").
+func Duration() validator.String {
+	return duration{}
+}
+
+type duration struct{}
+
+func (v duration) Description(ctx context.Context) string {
+	return "Ensures the value is a valid non-negative duration (e.g. \"30s\", \"1m30s\")"
+}
+
+func (v duration) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v duration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	d, err := time.ParseDuration(value)
+	if err == nil && d < 0 {
+		err = fmt.Errorf("duration must not be negative")
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Value '%s' is not a valid duration: %s", value, err),
+		)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package schemavalidate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UniqueStringSetAttribute returns a validator that ensures that each
+// element in a set has different values for the specified string attribute.
+//
+// Values that aren't known yet are not compared.
+func UniqueStringSetAttribute(name string) validator.Set {
+	return uniqueStringSetAttribute{name: name}
+}
+
+type uniqueStringSetAttribute struct {
+	name string
+}
+
+func (v uniqueStringSetAttribute) Description(ctx context.Context) string {
+	return fmt.Sprintf("Ensures all entries have unique values for string attribute '%s'", v.name)
+}
+
+func (v uniqueStringSetAttribute) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueStringSetAttribute) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	seen := make(map[string]bool)
+
+	for _, element := range elements {
+		if element.IsNull() || element.IsUnknown() {
+			continue
+		}
+
+		obj, ok := element.(types.Object)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(element),
+				"Validation Error",
+				"Element is not a types.Object",
+			)
+			return
+		}
+		attrs := obj.Attributes()
+		attr, exists := attrs[v.name]
+		if !exists {
+			continue
+		}
+
+		a, ok := attr.(types.String)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(element).AtName(v.name),
+				"Validation Error",
+				"Element attribute is not of types.String",
+			)
+			return
+		}
+		if a.IsNull() || a.IsUnknown() {
+			continue
+		}
+
+		value := a.ValueString()
+		if seen[value] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtSetValue(element).AtName(v.name),
+				"Duplicate Value",
+				fmt.Sprintf("Value '%s' for attribute '%s' must be unique across all entries", value, v.name),
+			)
+			return
+		}
+		seen[value] = true
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package schemavalidate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testElementType = types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType}}
+
+func testElement(name types.String) attr.Value {
+	return types.ObjectValueMust(testElementType.AttrTypes, map[string]attr.Value{"name": name})
+}
+
+func validateUniqueStringSet(elements ...attr.Value) *validator.SetResponse {
+	req := validator.SetRequest{
+		Path:        path.Root("entries"),
+		ConfigValue: types.SetValueMust(testElementType, elements),
+	}
+	resp := &validator.SetResponse{}
+	UniqueStringSetAttribute("name").ValidateSet(context.Background(), req, resp)
+	return resp
+}
+
+func TestUniqueStringSetAttribute(t *testing.T) {
+	resp := validateUniqueStringSet(
+		testElement(types.StringValue("a")),
+		testElement(types.StringValue("b")),
+	)
+	assert.False(t, resp.Diagnostics.HasError())
+}
+
+func TestUniqueStringSetAttribute_Duplicate(t *testing.T) {
+	elementType := types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "version": types.Int64Type}}
+	element := func(name string, version int64) attr.Value {
+		return types.ObjectValueMust(elementType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue(name),
+			"version": types.Int64Value(version),
+		})
+	}
+	req := validator.SetRequest{
+		Path:        path.Root("entries"),
+		ConfigValue: types.SetValueMust(elementType, []attr.Value{element("a", 1), element("a", 2)}),
+	}
+	resp := &validator.SetResponse{}
+	UniqueStringSetAttribute("name").ValidateSet(context.Background(), req, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Duplicate Value", resp.Diagnostics.Errors()[0].Summary())
+}
+
+func TestUniqueStringSetAttribute_UnknownValues(t *testing.T) {
+	resp := validateUniqueStringSet(
+		testElement(types.StringUnknown()),
+		testElement(types.StringValue("a")),
+	)
+	assert.False(t, resp.Diagnostics.HasError())
+}
@@ -0,0 +1,28 @@
+// Copyright Stacklet, Inc. 2025, 2026
+
+package typehelpers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StringsSet returns a set of values of string type.
+func StringsSet(l []string) types.Set {
+	sl := make([]attr.Value, len(l))
+	for i, item := range l {
+		sl[i] = types.StringValue(item)
+	}
+	sv, _ := types.SetValue(types.StringType, sl)
+	return sv
+}
+
+// SetStrings returns the elements of a types.Set of strings.
+func SetStrings(ctx context.Context, s types.Set) ([]string, diag.Diagnostics) {
+	l := make([]string, 0, len(s.Elements()))
+	diags := s.ElementsAs(ctx, &l, false)
+	return l, diags
+}